@@ -0,0 +1,99 @@
+// Package bench measures how long each phase of a solver takes and keeps a
+// history of the measurements so regressions can be spotted between runs.
+package bench
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+const (
+	PhaseParse = "parse"
+	PhasePart1 = "part1"
+	PhasePart2 = "part2"
+)
+
+// Result is the measurement of a single phase of a day's solver.
+type Result struct {
+	Day         int    `json:"day"`
+	Phase       string `json:"phase"`
+	Iterations  int    `json:"iterations"`
+	NsPerOp     int64  `json:"nsPerOp"`
+	AllocsPerOp int64  `json:"allocsPerOp"`
+	BytesPerOp  int64  `json:"bytesPerOp"`
+}
+
+var initTesting sync.Once
+
+// SetBenchtime sets how long each phase is run for. It defaults to one
+// second, like go test -bench.
+func SetBenchtime(d time.Duration) error {
+	// testing.Benchmark reads the duration from the test.benchtime flag,
+	// which only exists once the testing flags are registered
+	initTesting.Do(testing.Init)
+
+	return flag.Set("test.benchtime", d.String())
+}
+
+// Day benchmarks parsing the input and solving both parts of a day's puzzle.
+// Every phase is run once before it is measured so that a failing solver is
+// reported as an error instead of being timed.
+func Day(day int, input []byte) ([]*Result, error) {
+	solver, ok := puzzle.Lookup(day)
+	if !ok {
+		return nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	if err := solver.Parse(bytes.NewReader(input)); err != nil {
+		return nil, err
+	}
+
+	var results []*Result
+
+	parse := testing.Benchmark(func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			solver, _ := puzzle.Lookup(day)
+			solver.Parse(bytes.NewReader(input))
+		}
+	})
+	results = append(results, newResult(day, PhaseParse, parse))
+
+	for _, part := range []int{1, 2} {
+		if _, err := puzzle.Solve(solver, part); err != nil {
+			return nil, fmt.Errorf("part %d: %v", part, err)
+		}
+
+		result := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				puzzle.Solve(solver, part)
+			}
+		})
+
+		phase := PhasePart1
+		if part == 2 {
+			phase = PhasePart2
+		}
+		results = append(results, newResult(day, phase, result))
+	}
+
+	return results, nil
+}
+
+func newResult(day int, phase string, result testing.BenchmarkResult) *Result {
+	return &Result{
+		Day:         day,
+		Phase:       phase,
+		Iterations:  result.N,
+		NsPerOp:     result.NsPerOp(),
+		AllocsPerOp: result.AllocsPerOp(),
+		BytesPerOp:  result.AllocedBytesPerOp(),
+	}
+}
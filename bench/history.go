@@ -0,0 +1,75 @@
+package bench
+
+import (
+	"time"
+
+	"github.com/darthchudi/aoc2022/jsonlist"
+)
+
+// Run is a set of results measured together.
+type Run struct {
+	Time    time.Time `json:"time"`
+	Results []*Result `json:"results"`
+}
+
+// Regression is a phase that got slower than in the previous run.
+type Regression struct {
+	Day      int
+	Phase    string
+	Previous int64   // ns/op in the previous run
+	Current  int64   // ns/op in the current run
+	Change   float64 // relative change, 0.25 meaning 25% slower
+}
+
+// LoadHistory reads the runs recorded in the history file at path, oldest
+// first.
+func LoadHistory(path string) ([]*Run, error) {
+	return jsonlist.Load[*Run](path)
+}
+
+// AppendHistory records a run at the end of the history file at path.
+func AppendHistory(path string, run *Run) error {
+	return jsonlist.Append(path, run)
+}
+
+// Previous returns the most recent result recorded for a day's phase, or nil
+// if that phase has never been measured.
+func Previous(history []*Run, day int, phase string) *Result {
+	for i := len(history) - 1; i >= 0; i-- {
+		for _, result := range history[i].Results {
+			if result.Day == day && result.Phase == phase {
+				return result
+			}
+		}
+	}
+
+	return nil
+}
+
+// Compare returns the phases in current that are slower than their most
+// recent measurement in history by more than threshold, 0.1 meaning 10%.
+func Compare(history []*Run, current *Run, threshold float64) []*Regression {
+	var regressions []*Regression
+
+	for _, result := range current.Results {
+		previous := Previous(history, result.Day, result.Phase)
+		if previous == nil || previous.NsPerOp == 0 {
+			continue
+		}
+
+		change := float64(result.NsPerOp-previous.NsPerOp) / float64(previous.NsPerOp)
+		if change <= threshold {
+			continue
+		}
+
+		regressions = append(regressions, &Regression{
+			Day:      result.Day,
+			Phase:    result.Phase,
+			Previous: previous.NsPerOp,
+			Current:  result.NsPerOp,
+			Change:   change,
+		})
+	}
+
+	return regressions
+}
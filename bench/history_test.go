@@ -0,0 +1,48 @@
+package bench
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	history := []*Run{
+		{Results: []*Result{
+			{Day: 1, Phase: PhaseParse, NsPerOp: 1000},
+			{Day: 1, Phase: PhasePart1, NsPerOp: 0},
+			{Day: 1, Phase: PhasePart2, NsPerOp: 500},
+		}},
+		{Results: []*Result{
+			{Day: 1, Phase: PhaseParse, NsPerOp: 100},
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		result    *Result
+		regressed bool
+	}{
+		{"no previous result", &Result{Day: 2, Phase: PhaseParse, NsPerOp: 1000}, false},
+		{"previously zero", &Result{Day: 1, Phase: PhasePart1, NsPerOp: 1000}, false},
+		{"just below the threshold", &Result{Day: 1, Phase: PhaseParse, NsPerOp: 109}, false},
+		{"at the threshold", &Result{Day: 1, Phase: PhaseParse, NsPerOp: 110}, false},
+		{"just above the threshold", &Result{Day: 1, Phase: PhaseParse, NsPerOp: 111}, true},
+		{"faster", &Result{Day: 1, Phase: PhasePart2, NsPerOp: 100}, false},
+		{"much slower", &Result{Day: 1, Phase: PhasePart2, NsPerOp: 5000}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			regressions := Compare(history, &Run{Results: []*Result{test.result}}, 0.1)
+			if regressed := len(regressions) > 0; regressed != test.regressed {
+				t.Fatalf("regressed = %t, want %t", regressed, test.regressed)
+			}
+			if !test.regressed {
+				return
+			}
+
+			regression := regressions[0]
+			previous := Previous(history, test.result.Day, test.result.Phase).NsPerOp
+			if regression.Previous != previous || regression.Current != test.result.NsPerOp {
+				t.Errorf("regression from %d to %d, want %d to %d", regression.Previous, regression.Current, previous, test.result.NsPerOp)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Package client talks to the Advent of Code website to download puzzle
+// inputs, keeping a copy of every input on disk so it is only downloaded once.
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Year is the year of the advent calendar the solvers are for.
+	Year = 2022
+
+	// DefaultBaseURL is the address of the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+
+	userAgent = "github.com/darthchudi/aoc2022"
+)
+
+// Environment variables used to configure a client.
+const (
+	EnvSession     = "AOC_SESSION"      // session token
+	EnvSessionFile = "AOC_SESSION_FILE" // file holding the session token
+	EnvBaseURL     = "AOC_BASE_URL"     // address of the website, for testing against a stand-in
+	EnvCacheDir    = "AOC_CACHE_DIR"    // directory inputs are cached in
+)
+
+// ErrNoSession is returned when an input has to be downloaded but no session
+// token is configured.
+var ErrNoSession = errors.New("no session token: set " + EnvSession + " or save the token in " + EnvSessionFile)
+
+// Client downloads puzzle inputs on behalf of a logged in user.
+type Client struct {
+	BaseURL    string // address of the website, without a trailing slash
+	Session    string // value of the session cookie of a logged in user
+	CacheDir   string // directory inputs are cached in
+	HTTPClient *http.Client
+}
+
+// New returns a client configured from the environment. The session token is
+// read from AOC_SESSION, or from the file named by AOC_SESSION_FILE, falling
+// back to a session file in the user's config directory. Inputs are cached in
+// the user's cache directory unless AOC_CACHE_DIR is set.
+func New() (*Client, error) {
+	session, err := loadSession()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheDir := os.Getenv(EnvCacheDir)
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		cacheDir = filepath.Join(userCacheDir, "aoc")
+	}
+
+	baseURL := os.Getenv(EnvBaseURL)
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		Session:    session,
+		CacheDir:   cacheDir,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func loadSession() (string, error) {
+	if session := os.Getenv(EnvSession); session != "" {
+		return session, nil
+	}
+
+	sessionFile := os.Getenv(EnvSessionFile)
+	if sessionFile == "" {
+		configDir, err := os.UserConfigDir()
+		if err != nil {
+			// Without a config directory there is nowhere to look for a session
+			return "", nil
+		}
+		sessionFile = filepath.Join(configDir, "aoc", "session")
+	}
+
+	b, err := ioutil.ReadFile(sessionFile)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// InputPath returns where the input for a day is cached.
+func (c *Client) InputPath(day int) string {
+	return filepath.Join(c.CacheDir, strconv.Itoa(Year), fmt.Sprintf("day%d.txt", day))
+}
+
+// Input returns the path of the cached input for a day, downloading it first
+// if it isn't cached yet.
+func (c *Client) Input(day int) (string, error) {
+	path := c.InputPath(day)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	b, err := c.Fetch(day)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first so an interrupted download never
+	// leaves a partial input in the cache
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return "", err
+	}
+
+	return path, os.Rename(tmp, path)
+}
+
+// Fetch downloads the input for a day, bypassing the cache.
+func (c *Client) Fetch(day int) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	url := fmt.Sprintf("%s/%d/day/%d/input", c.BaseURL, Year, day)
+	req, err := c.newRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch input for day %d: %s: %s", day, res.Status, strings.TrimSpace(string(b)))
+	}
+
+	return b, nil
+}
+
+func (c *Client) newRequest(method, url string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+
+	return req, nil
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:    server.URL,
+		Session:    "secret",
+		CacheDir:   t.TempDir(),
+		HTTPClient: server.Client(),
+	}
+}
+
+func TestInputCachesDownload(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Path != "/2022/day/3/input" {
+			t.Errorf("requested %s, want /2022/day/3/input", r.URL.Path)
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "secret" {
+			t.Errorf("session cookie = %v, want secret", cookie)
+		}
+
+		w.Write([]byte("vJrwpWtwJgWrhcsFMMfFFhFp\n"))
+	})
+
+	for i := 0; i < 2; i++ {
+		path, err := c.Input(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != c.InputPath(3) {
+			t.Errorf("path = %s, want %s", path, c.InputPath(3))
+		}
+
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "vJrwpWtwJgWrhcsFMMfFFhFp\n" {
+			t.Errorf("cached input = %q", b)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+}
+
+func TestInputDoesNotCacheErrors(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "Please don't repeatedly request this endpoint before it unlocks!", http.StatusNotFound)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Input(25); err == nil {
+			t.Fatal("expected an error for a locked day")
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("made %d requests, want 2", requests)
+	}
+}
+
+func TestInputWithoutSession(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request without a session")
+	})
+	c.Session = ""
+
+	if _, err := c.Input(1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("err = %v, want ErrNoSession", err)
+	}
+}
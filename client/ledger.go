@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/darthchudi/aoc2022/jsonlist"
+)
+
+// Attempt is an answer that was submitted, along with its verdict.
+type Attempt struct {
+	Time    time.Time `json:"time"`
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict string    `json:"verdict"`
+}
+
+// LoadLedger reads the attempts recorded in the ledger at path, oldest first.
+func LoadLedger(path string) ([]*Attempt, error) {
+	return jsonlist.Load[*Attempt](path)
+}
+
+// AppendLedger records an attempt at the end of the ledger at path.
+func AppendLedger(path string, attempt *Attempt) error {
+	return jsonlist.Append(path, attempt)
+}
+
+// CheckAnswer returns an error if the ledger shows that submitting an answer
+// is pointless: the part was already solved, the same answer was already
+// rejected, or a numeric answer is outside the bounds set by earlier too high
+// and too low verdicts.
+func CheckAnswer(ledger []*Attempt, day, part int, answer string) error {
+	number, err := strconv.Atoi(answer)
+	isNumber := err == nil
+
+	for _, attempt := range ledger {
+		if attempt.Day != day || attempt.Part != part {
+			continue
+		}
+
+		switch attempt.Verdict {
+		case VerdictCorrect:
+			return fmt.Errorf("day %d part %d was already solved with %s", day, part, attempt.Answer)
+		case VerdictWrong, VerdictTooHigh, VerdictTooLow:
+			if attempt.Answer == answer {
+				return fmt.Errorf("%s was already submitted for day %d part %d and was %s", answer, day, part, attempt.Verdict)
+			}
+		}
+
+		bound, err := strconv.Atoi(attempt.Answer)
+		if !isNumber || err != nil {
+			continue
+		}
+
+		if attempt.Verdict == VerdictTooHigh && number >= bound {
+			return fmt.Errorf("%d is too high: %d was already too high", number, bound)
+		}
+		if attempt.Verdict == VerdictTooLow && number <= bound {
+			return fmt.Errorf("%d is too low: %d was already too low", number, bound)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdicts the website gives for a submitted answer.
+const (
+	VerdictCorrect     = "correct"
+	VerdictWrong       = "wrong"
+	VerdictTooHigh     = "too high"
+	VerdictTooLow      = "too low"
+	VerdictRateLimited = "rate limited"
+	VerdictUnknown     = "unknown" // the response page wasn't recognised
+)
+
+// Response is the website's reply to a submitted answer.
+type Response struct {
+	Verdict string
+	Wait    time.Duration // how long to wait before submitting again, if rate limited
+	Message string        // text of the response page
+}
+
+var (
+	articleRegex = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRegex     = regexp.MustCompile(`<[^>]*>`)
+	waitRegex    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+)
+
+// Submit posts the answer to one part of a day's puzzle and reports the
+// verdict.
+func (c *Client) Submit(day, part int, answer string) (*Response, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	form := url.Values{
+		"level":  {strconv.Itoa(part)},
+		"answer": {answer},
+	}
+
+	url := fmt.Sprintf("%s/%d/day/%d/answer", c.BaseURL, Year, day)
+	req, err := c.newRequest(http.MethodPost, url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	b, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to submit answer for day %d part %d: %s", day, part, res.Status)
+	}
+
+	return parseResponse(string(b)), nil
+}
+
+// parseResponse works out the verdict from the page returned after submitting
+// an answer.
+func parseResponse(page string) *Response {
+	message := page
+	if match := articleRegex.FindStringSubmatch(page); match != nil {
+		message = match[1]
+	}
+	message = strings.Join(strings.Fields(tagRegex.ReplaceAllString(message, "")), " ")
+
+	response := &Response{Verdict: VerdictUnknown, Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		response.Verdict = VerdictCorrect
+	case strings.Contains(message, "You gave an answer too recently"):
+		response.Verdict = VerdictRateLimited
+		if match := waitRegex.FindStringSubmatch(message); match != nil {
+			minutes, _ := strconv.Atoi(match[1])
+			seconds, _ := strconv.Atoi(match[2])
+			response.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	case strings.Contains(message, "your answer is too high"):
+		response.Verdict = VerdictTooHigh
+	case strings.Contains(message, "your answer is too low"):
+		response.Verdict = VerdictTooLow
+	case strings.Contains(message, "That's not the right answer"):
+		response.Verdict = VerdictWrong
+	}
+
+	return response
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// page wraps a message the way the website's answer page does.
+func page(message string) string {
+	return fmt.Sprintf("<html><body><main>\n<article><p>%s</p></article>\n</main></body></html>", message)
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		page    string
+		verdict string
+		wait    time.Duration
+	}{
+		{page("That's the right answer! You are one gold star closer to collecting enough star fruit."), VerdictCorrect, 0},
+		{page("That's not the right answer. If you're stuck, make sure you're using the full input data."), VerdictWrong, 0},
+		{page("That's not the right answer; your answer is too high. Please wait one minute before trying again."), VerdictTooHigh, 0},
+		{page("That's not the right answer; your answer is too low. Please wait one minute before trying again."), VerdictTooLow, 0},
+		{page("You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 4m 52s left to wait."), VerdictRateLimited, 4*time.Minute + 52*time.Second},
+		{page("You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 38s left to wait."), VerdictRateLimited, 38 * time.Second},
+		{page("You don't seem to be solving the right level. Did you already complete it?"), VerdictUnknown, 0},
+	}
+
+	for _, test := range tests {
+		response := parseResponse(test.page)
+		if response.Verdict != test.verdict || response.Wait != test.wait {
+			t.Errorf("parseResponse(%q) = %s after %v, want %s after %v", response.Message, response.Verdict, response.Wait, test.verdict, test.wait)
+		}
+	}
+}
+
+func TestSubmitFlow(t *testing.T) {
+	submitted := map[string]bool{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2022/day/1/answer" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.FormValue("level") != "1" {
+			t.Errorf("level = %q, want 1", r.FormValue("level"))
+		}
+
+		answer := r.FormValue("answer")
+		if submitted[answer] {
+			t.Errorf("%s was submitted twice", answer)
+		}
+		submitted[answer] = true
+
+		switch answer {
+		case "100":
+			fmt.Fprint(w, page("That's not the right answer; your answer is too low."))
+		case "300":
+			fmt.Fprint(w, page("That's not the right answer; your answer is too high."))
+		case "200":
+			fmt.Fprint(w, page("That's the right answer!"))
+		default:
+			fmt.Fprint(w, page("That's not the right answer."))
+		}
+	})
+	ledgerPath := filepath.Join(t.TempDir(), "answers.json")
+
+	submit := func(answer string) (string, error) {
+		ledger, err := LoadLedger(ledgerPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckAnswer(ledger, 1, 1, answer); err != nil {
+			return "", err
+		}
+
+		response, err := c.Submit(1, 1, answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		attempt := &Attempt{Time: time.Now(), Day: 1, Part: 1, Answer: answer, Verdict: response.Verdict}
+		if err := AppendLedger(ledgerPath, attempt); err != nil {
+			t.Fatal(err)
+		}
+
+		return response.Verdict, nil
+	}
+
+	steps := []struct {
+		answer  string
+		verdict string // empty when the answer should be rejected locally
+	}{
+		{"100", VerdictTooLow},
+		{"100", ""},
+		{"50", ""},
+		{"300", VerdictTooHigh},
+		{"301", ""},
+		{"abc", VerdictWrong},
+		{"abc", ""},
+		{"250", VerdictWrong},
+		{"250", ""},
+		{"200", VerdictCorrect},
+		{"201", ""},
+	}
+
+	for _, step := range steps {
+		verdict, err := submit(step.answer)
+		if step.verdict == "" {
+			if err == nil {
+				t.Errorf("%s: expected it to be rejected locally, got %s", step.answer, verdict)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", step.answer, err)
+		} else if verdict != step.verdict {
+			t.Errorf("%s: verdict = %s, want %s", step.answer, verdict, step.verdict)
+		}
+	}
+
+	ledger, err := LoadLedger(ledgerPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ledger) != 5 {
+		t.Errorf("ledger has %d attempts, want 5", len(ledger))
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/darthchudi/aoc2022/bench"
+	"github.com/darthchudi/aoc2022/client"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func benchCommand(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	day := flags.Int("day", 0, "day of the puzzle to benchmark; every day with an input is benchmarked when omitted")
+	inputFile := flags.String("input", "", "path to the puzzle input when benchmarking a single day (default dayN/input.txt or the cached download)")
+	benchtime := flags.Duration("benchtime", time.Second, "how long to run each phase for")
+	historyFile := flags.String("history", ".aoc/bench-history.json", "file the results of every run are appended to")
+	threshold := flags.Float64("threshold", 0.1, "slowdown compared to the previous run that counts as a regression, 0.1 meaning 10%")
+	flags.Parse(args)
+
+	if err := bench.SetBenchtime(*benchtime); err != nil {
+		return err
+	}
+
+	days := puzzle.Days()
+	if *day != 0 {
+		days = []int{*day}
+	} else if *inputFile != "" {
+		return fmt.Errorf("-input can only be used with -day")
+	}
+
+	history, err := bench.LoadHistory(*historyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load benchmark history: %v", err)
+	}
+
+	run := &bench.Run{Time: time.Now().UTC()}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "day\tphase\tns/op\tallocs/op\tB/op\tprevious ns/op\tchange\t")
+
+	for _, d := range days {
+		path, err := inputPath(d, *inputFile)
+		if errors.Is(err, client.ErrNoSession) && *day == 0 {
+			fmt.Fprintf(os.Stderr, "skipping day %d: no input\n", d)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		input, err := readInput(path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "benchmarking day %d\n", d)
+		results, err := bench.Day(d, input)
+		if err != nil {
+			return describeParseError(err, d, path)
+		}
+
+		for _, result := range results {
+			previousNs, change := "-", "-"
+			if previous := bench.Previous(history, result.Day, result.Phase); previous != nil && previous.NsPerOp != 0 {
+				previousNs = fmt.Sprint(previous.NsPerOp)
+				change = fmt.Sprintf("%+.1f%%", 100*float64(result.NsPerOp-previous.NsPerOp)/float64(previous.NsPerOp))
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t%d\t%s\t%s\t\n", result.Day, result.Phase, result.NsPerOp, result.AllocsPerOp, result.BytesPerOp, previousNs, change)
+		}
+
+		run.Results = append(run.Results, results...)
+	}
+	w.Flush()
+
+	for _, regression := range bench.Compare(history, run, *threshold) {
+		fmt.Printf("regression: day %d %s went from %d to %d ns/op (%+.1f%%)\n",
+			regression.Day, regression.Phase, regression.Previous, regression.Current, 100*regression.Change)
+	}
+
+	if len(run.Results) == 0 {
+		return nil
+	}
+
+	if err := bench.AppendHistory(*historyFile, run); err != nil {
+		return fmt.Errorf("failed to save benchmark history: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/darthchudi/aoc2022/day1"
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func caloriesCommand(args []string) error {
+	flags := flag.NewFlagSet("calories", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 1 puzzle input, or - for stdin (default day1/input.txt or the cached download)")
+	top := flags.Int("top", 3, "number of elves to list at the top, not counting ties")
+	buckets := flags.Int("buckets", 10, "number of buckets in the calorie histogram")
+	format := flags.String("format", "table", "output format: table or json")
+	flags.Parse(args)
+
+	if *format != "table" && *format != formatJSON {
+		return fmt.Errorf("unknown format %q, expected table or %s", *format, formatJSON)
+	}
+
+	path, err := inputPath(1, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	file, err := input.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer file.Close()
+
+	elfStats, err := day1.ReadElfStats(file)
+	if err != nil {
+		return describeParseError(err, 1, path)
+	}
+
+	report, err := day1.NewReport(elfStats, *top, *buckets)
+	if err != nil {
+		return err
+	}
+
+	if *format == formatJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	return report.WriteTable(os.Stdout)
+}
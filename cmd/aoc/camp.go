@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/darthchudi/aoc2022/day4"
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func campCommand(args []string) error {
+	flags := flag.NewFlagSet("camp", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 4 puzzle input, or - for stdin (default day4/input.txt or the cached download)")
+	listPairs := flags.Bool("pairs", false, "list every pair of elves whose assignments overlap")
+	listGroups := flags.Bool("groups", false, "compare the elves in the group on each line")
+	format := flags.String("format", "table", "output format: table or json")
+	flags.Parse(args)
+
+	if *format != "table" && *format != formatJSON {
+		return fmt.Errorf("unknown format %q, expected table or %s", *format, formatJSON)
+	}
+
+	path, err := inputPath(4, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	file, err := input.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer file.Close()
+
+	lines, err := day4.ReadAssignments(file)
+	if err != nil {
+		return describeParseError(err, 4, path)
+	}
+
+	report := day4.AnalyzeCamp(day4.Assignments(lines))
+	if *listGroups {
+		for _, group := range lines {
+			report.Groups = append(report.Groups, day4.AnalyzeGroup(group))
+		}
+	}
+
+	if *format == formatJSON {
+		if !*listPairs {
+			report.OverlappingPairs = nil
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	return report.WriteTable(os.Stdout, *listPairs)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/day5"
+)
+
+func cranesCommand(args []string) error {
+	flags := flag.NewFlagSet("cranes", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 5 puzzle input, or - for stdin (default day5/input.txt or the cached download)")
+	var maxLiftFlags stringList
+	flags.Var(&maxLiftFlags, "max-lift", "also run a crane that lifts up to K crates at a time; can be repeated")
+	flags.Parse(args)
+
+	cranes := day5.Cranes()
+	for _, value := range maxLiftFlags {
+		maxLift, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid -max-lift %q: %v", value, err)
+		}
+
+		crane, err := day5.NewCrateMover(maxLift)
+		if err != nil {
+			return err
+		}
+		cranes = append(cranes, crane)
+	}
+
+	solver, _, err := loadPuzzle(5, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "crane\tmessage")
+	for _, crane := range cranes {
+		message, err := solver.(*day5.Solver).Rearrange(crane)
+		if err != nil {
+			return fmt.Errorf("%s: %v", crane.Name(), err)
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\n", crane.Name(), message)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/darthchudi/aoc2022/client"
+)
+
+func fetchCommand(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	day := flags.Int("day", 0, "day of the puzzle to download the input for")
+	flags.Parse(args)
+
+	if *day < 1 || *day > 25 {
+		return fmt.Errorf("invalid day: %d", *day)
+	}
+
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+
+	path, err := c.Input(*day)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(path)
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/day2"
+)
+
+// stringList is a flag that can be given several times.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, " ")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func guideCommand(args []string) error {
+	flags := flag.NewFlagSet("guide", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 2 puzzle input, or - for stdin (default day2/input.txt or the cached download)")
+	gameFile := flags.String("game", "", "game definition file (default rock paper scissors)")
+	var interpretationFlags stringList
+	flags.Var(&interpretationFlags, "interpretation", "interpretation of the second column: shape, outcome or NAME:X=SHAPE,Y=OUTCOME,...; can be repeated (default shape and outcome)")
+	flags.Parse(args)
+
+	if len(interpretationFlags) == 0 {
+		interpretationFlags = day2.InterpretationNames()
+	}
+
+	game, err := loadGame(*gameFile)
+	if err != nil {
+		return err
+	}
+
+	var interpretations []*day2.Interpretation
+	for _, value := range interpretationFlags {
+		interpretation, err := parseInterpretationFlag(game, value)
+		if err != nil {
+			return err
+		}
+
+		interpretations = append(interpretations, interpretation)
+	}
+
+	rounds, err := readStrategyGuide(*inputFile)
+	if err != nil {
+		return err
+	}
+
+	comparison, err := day2.CompareInterpretations(game, rounds, interpretations)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprint(w, "interpretation\tscore\n")
+	for idx, interpretation := range comparison.Interpretations {
+		fmt.Fprintf(w, "%s\t%d\n", interpretation.Name, comparison.Scores[idx])
+	}
+
+	if len(comparison.Interpretations) > 1 {
+		fmt.Fprintf(w, "\n%d of %d rounds differ\n", len(comparison.Differences), len(rounds))
+	}
+
+	if len(comparison.Differences) > 0 {
+		fmt.Fprint(w, "\nround\topponent\tcolumn")
+		for _, interpretation := range comparison.Interpretations {
+			fmt.Fprintf(w, "\t%s", interpretation.Name)
+		}
+		fmt.Fprintln(w)
+
+		for _, play := range comparison.Differences {
+			fmt.Fprintf(w, "%d\t%s\t%s", play.Round, game.Name(play.OpponentShape), play.Column)
+			for idx, shape := range play.PlayerShapes {
+				fmt.Fprintf(w, "\t%s (%d)", game.Name(shape), play.PlayerScores[idx])
+			}
+			fmt.Fprintln(w)
+		}
+	}
+
+	return w.Flush()
+}
+
+// readStrategyGuide reads the rounds of the day 2 strategy guide.
+func readStrategyGuide(inputFile string) ([][]string, error) {
+	path, err := inputPath(2, inputFile)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readInput(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds, err := day2.ReadStrategyGuide(bytes.NewReader(data))
+	if err != nil {
+		return nil, describeParseError(err, 2, path)
+	}
+
+	return rounds, nil
+}
+
+// loadGame reads a day 2 game definition file, or returns the default game if
+// path is empty.
+func loadGame(path string) (*day2.Game, error) {
+	if path == "" {
+		return day2.DefaultGame(), nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	game, err := day2.LoadGame(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return game, nil
+}
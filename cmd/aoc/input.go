@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/client"
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+// inputPath returns the path of the puzzle input for a day. When no path was
+// given it uses dayN/input.txt if it exists, and otherwise the cached input,
+// downloading it first if needed.
+func inputPath(day int, path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+
+	local := fmt.Sprintf("day%d/input.txt", day)
+	if _, err := os.Stat(local); err == nil {
+		return local, nil
+	}
+
+	c, err := client.New()
+	if err != nil {
+		return "", err
+	}
+
+	return c.Input(day)
+}
+
+// loadPuzzle looks up the solver for a day and parses its puzzle input,
+// returning the solver along with the raw input.
+func loadPuzzle(day int, inputFile string) (puzzle.Solver, []byte, error) {
+	solver, ok := puzzle.Lookup(day)
+	if !ok {
+		return nil, nil, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	path, err := inputPath(day, inputFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := readInput(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := solver.Parse(bytes.NewReader(data)); err != nil {
+		return nil, nil, describeParseError(err, day, path)
+	}
+
+	return solver, data, nil
+}
+
+// readInput reads the whole puzzle input at path, which may be input.Stdin.
+func readInput(path string) ([]byte, error) {
+	file, err := input.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open input: %w", err)
+	}
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
+
+// describeParseError turns a parse error into a compiler style diagnostic
+// pointing into the input file. Other errors are returned as they are. The
+// day is 0 for files that aren't puzzle inputs, such as priority tables.
+func describeParseError(err error, day int, path string) error {
+	var parseError *input.ParseError
+	if !errors.As(err, &parseError) {
+		if day == 0 {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		return fmt.Errorf("day %d: %v", day, err)
+	}
+
+	// Errors from the shared input readers don't know which day they are for
+	if parseError.Day == 0 {
+		parseError.Day = day
+	}
+
+	filename := path
+	if filename == input.Stdin {
+		filename = "<stdin>"
+	}
+
+	return errors.New(strings.TrimSuffix(parseError.Diagnostic(filename), "\n"))
+}
@@ -0,0 +1,117 @@
+// Command aoc runs the Advent of Code 2022 solvers.
+//
+// Usage:
+//
+//	aoc run -day 7 -part 2 -input path/to/file
+//	aoc bench -day 11
+//	aoc calories -top 3
+//	aoc camp -pairs
+//	aoc cranes -max-lift 2
+//	aoc fetch -day 7
+//	aoc guide -interpretation shape -interpretation outcome
+//	aoc rucksacks -compartments 4 -min-shared 3
+//	aoc submit -day 7 -part 1
+//	aoc new -day 12
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	_ "github.com/darthchudi/aoc2022/days"
+)
+
+type command struct {
+	usage string
+	run   func(args []string) error
+}
+
+var commands = map[string]*command{
+	"bench": {
+		usage: "bench [-day N [-input FILE]] [-benchtime D] [-history FILE] [-threshold F]",
+		run:   benchCommand,
+	},
+	"calories": {
+		usage: "calories [-input FILE] [-top N] [-buckets N] [-format table|json]",
+		run:   caloriesCommand,
+	},
+	"camp": {
+		usage: "camp [-input FILE] [-pairs] [-groups] [-format table|json]",
+		run:   campCommand,
+	},
+	"cranes": {
+		usage: "cranes [-input FILE] [-max-lift K]...",
+		run:   cranesCommand,
+	},
+	"fetch": {
+		usage: "fetch -day N",
+		run:   fetchCommand,
+	},
+	"guide": {
+		usage: "guide [-input FILE] [-game FILE] [-interpretation NAME[:MAPPING]]...",
+		run:   guideCommand,
+	},
+	"new": {
+		usage: "new -day N [-root DIR]",
+		run:   newCommand,
+	},
+	"optimize": {
+		usage: "optimize [-input FILE] [-game FILE] [-max-wins K] [-max-consecutive-losses M] [-responses]",
+		run:   optimizeCommand,
+	},
+	"rounds": {
+		usage: "rounds [-input FILE] [-game FILE] [-interpretation NAME[:MAPPING]] [-format jsonl|csv] [-output FILE]",
+		run:   roundsCommand,
+	},
+	"rucksacks": {
+		usage: "rucksacks [-input FILE] [-compartments K] [-min-shared M] [-group-size N] [-priorities FILE]",
+		run:   rucksacksCommand,
+	},
+	"run": {
+		usage: "run -day N [-part P] [-input FILE] [-format text|json|tsv]",
+		run:   runCommand,
+	},
+	"simulate": {
+		usage: "simulate [-strategies S,S,...] [-matches N] [-rounds N] [-seed S] [-input FILE] [-game FILE]",
+		run:   simulateCommand,
+	},
+	"submit": {
+		usage: "submit -day N -part P [-input FILE] [-ledger FILE]",
+		run:   submitCommand,
+	},
+}
+
+func usage() {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(os.Stderr, "usage:")
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  aoc %s\n", commands[name].usage)
+	}
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("aoc: ")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/darthchudi/aoc2022/scaffold"
+)
+
+func newCommand(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ExitOnError)
+	day := flags.Int("day", 0, "day to generate a package for")
+	root := flags.String("root", ".", "root of the repository")
+	flags.Parse(args)
+
+	paths, err := scaffold.Generate(*root, *day)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		fmt.Println(path)
+	}
+	fmt.Printf("add the puzzle's example inputs to days/testdata/day%d/ and run go test ./days -update once both parts are solved; until then the golden tests skip day %d\n", *day, *day)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/day2"
+)
+
+func optimizeCommand(args []string) error {
+	flags := flag.NewFlagSet("optimize", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 2 puzzle input, or - for stdin (default day2/input.txt or the cached download)")
+	gameFile := flags.String("game", "", "game definition file (default rock paper scissors)")
+	maxWins := flags.Int("max-wins", -1, "most rounds the player may win, or -1 for no limit")
+	maxLosses := flags.Int("max-consecutive-losses", -1, "longest losing streak the player may have, or -1 for no limit")
+	responses := flags.Bool("responses", false, "list the responses for every round")
+	flags.Parse(args)
+
+	game, err := loadGame(*gameFile)
+	if err != nil {
+		return err
+	}
+
+	rounds, err := readStrategyGuide(*inputFile)
+	if err != nil {
+		return err
+	}
+
+	opponentShapes, err := day2.OpponentShapes(game, rounds)
+	if err != nil {
+		return err
+	}
+	constraints := day2.Constraints{MaxWins: *maxWins, MaxConsecutiveLosses: *maxLosses}
+
+	best, err := day2.Optimize(game, opponentShapes, constraints, true)
+	if err != nil {
+		return err
+	}
+
+	worst, err := day2.Optimize(game, opponentShapes, constraints, false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("maximum score: %d\n", best.Score)
+	fmt.Printf("minimum score: %d\n", worst.Score)
+
+	if !*responses {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "\nround\topponent\tmaximum\tminimum\n")
+	for idx, opponentShape := range opponentShapes {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", idx+1, game.Name(opponentShape), game.Name(best.Responses[idx]), game.Name(worst.Responses[idx]))
+	}
+
+	return w.Flush()
+}
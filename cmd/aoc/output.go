@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+	formatTSV  = "tsv"
+)
+
+// result is the answer to one part of a puzzle along with how it was
+// computed, so results can be consumed by other programs.
+type result struct {
+	Day       int           `json:"day"`
+	Part      int           `json:"part"`
+	Answer    puzzle.Answer `json:"answer"`
+	Duration  time.Duration `json:"duration"`  // time spent solving the part, in nanoseconds
+	InputHash string        `json:"inputHash"` // hex encoded SHA-256 of the puzzle input
+}
+
+func checkFormat(format string) error {
+	switch format {
+	case formatText, formatJSON, formatTSV:
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q, expected %s, %s or %s", format, formatText, formatJSON, formatTSV)
+	}
+}
+
+// writeResults writes the results in one of the output formats. JSON output
+// has one record per line.
+func writeResults(w io.Writer, format string, results []*result) error {
+	switch format {
+	case formatJSON:
+		encoder := json.NewEncoder(w)
+		for _, r := range results {
+			if err := encoder.Encode(r); err != nil {
+				return err
+			}
+		}
+	case formatTSV:
+		fmt.Fprintln(w, "day\tpart\tanswer\tduration\tinputHash")
+		for _, r := range results {
+			// Escape line breaks so multi-line answers stay on one row
+			answer := strings.ReplaceAll(r.Answer.String(), "\n", `\n`)
+			fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%s\n", r.Day, r.Part, answer, r.Duration.Nanoseconds(), r.InputHash)
+		}
+	default:
+		for _, r := range results {
+			separator := " "
+			if strings.Contains(r.Answer.String(), "\n") {
+				// Start multi-line answers on their own line so they line up
+				separator = "\n"
+			}
+
+			fmt.Fprintf(w, "day %d part %d (%v):%s%s\n", r.Day, r.Part, r.Duration, separator, r.Answer)
+		}
+	}
+
+	return nil
+}
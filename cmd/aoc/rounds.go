@@ -0,0 +1,98 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/day2"
+)
+
+func roundsCommand(args []string) error {
+	flags := flag.NewFlagSet("rounds", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 2 puzzle input, or - for stdin (default day2/input.txt or the cached download)")
+	gameFile := flags.String("game", "", "game definition file (default rock paper scissors)")
+	interpretationFlag := flags.String("interpretation", "outcome", "interpretation of the second column: shape, outcome or NAME:X=SHAPE,Y=OUTCOME,...")
+	format := flags.String("format", "jsonl", "format of the round results: jsonl or csv")
+	output := flags.String("output", "-", "file to write the round results to, or - for stdout")
+	flags.Parse(args)
+
+	game, err := loadGame(*gameFile)
+	if err != nil {
+		return err
+	}
+
+	interpretation, err := parseInterpretationFlag(game, *interpretationFlag)
+	if err != nil {
+		return err
+	}
+
+	rounds, err := readStrategyGuide(*inputFile)
+	if err != nil {
+		return err
+	}
+
+	// The summary goes to stderr when the round results take up stdout
+	var w io.Writer = os.Stdout
+	summaryWriter := io.Writer(os.Stderr)
+	if *output != "-" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		w = file
+		summaryWriter = os.Stdout
+	}
+
+	var sink day2.RoundSink
+	var flush func() error
+	switch *format {
+	case "jsonl":
+		sink = day2.NewJSONLinesSink(w, game)
+	case "csv":
+		csvSink := day2.NewCSVSink(w, game)
+		sink, flush = csvSink, csvSink.Flush
+	default:
+		return fmt.Errorf("unknown format %q, expected jsonl or csv", *format)
+	}
+
+	gameMetrics, err := day2.PlayStrategyGuide(game, rounds, interpretation, sink)
+	if err != nil {
+		return err
+	}
+
+	if flush != nil {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+
+	summary := gameMetrics.Summary(game)
+
+	tw := tabwriter.NewWriter(summaryWriter, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "rounds\t%d\n", summary.Rounds)
+	fmt.Fprintf(tw, "player score\t%d\n", gameMetrics.PlayerScore())
+	fmt.Fprintf(tw, "opponent score\t%d\n", gameMetrics.OpponentScore())
+	fmt.Fprintf(tw, "longest win streak\t%d\n", summary.LongestWinStreak)
+	fmt.Fprintf(tw, "longest loss streak\t%d\n", summary.LongestLossStreak)
+	for _, record := range summary.ByOpponentShape {
+		fmt.Fprintf(tw, "win rate against %s\t%.3f (%d of %d)\n", game.Name(record.Shape), record.WinRate, record.Wins, record.Rounds)
+	}
+
+	return tw.Flush()
+}
+
+// parseInterpretationFlag returns the interpretation named by a flag, which is
+// either a built in interpretation or NAME:MAPPING.
+func parseInterpretationFlag(game *day2.Game, value string) (*day2.Interpretation, error) {
+	if name, mapping, ok := strings.Cut(value, ":"); ok {
+		return day2.ParseInterpretation(game, name, mapping)
+	}
+
+	return day2.LookupInterpretation(game, value)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/darthchudi/aoc2022/day3"
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func rucksacksCommand(args []string) error {
+	flags := flag.NewFlagSet("rucksacks", flag.ExitOnError)
+	inputFile := flags.String("input", "", "path to the day 3 puzzle input, or - for stdin (default day3/input.txt or the cached download)")
+	compartments := flags.Int("compartments", 2, "number of equally sized compartments in each rucksack")
+	minShared := flags.Int("min-shared", 0, "number of compartments an item must be in to count as shared (default every compartment)")
+	groupSize := flags.Int("group-size", 3, "number of elves in each group")
+	priorities := flags.String("priorities", "", "path to a priority table (default a-z from 1 and A-Z from 27)")
+	flags.Parse(args)
+
+	if *minShared == 0 {
+		*minShared = *compartments
+	}
+
+	table := day3.DefaultPriorityTable()
+	if *priorities != "" {
+		file, err := os.Open(*priorities)
+		if err != nil {
+			return fmt.Errorf("failed to open priority table: %w", err)
+		}
+		defer file.Close()
+
+		table, err = day3.LoadPriorityTable(file)
+		if err != nil {
+			return describeParseError(err, 0, *priorities)
+		}
+	}
+
+	path, err := inputPath(3, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	file, err := input.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open input: %w", err)
+	}
+	defer file.Close()
+
+	rucksacks, err := day3.ReadRucksacks(file, table, *compartments)
+	if err != nil {
+		return describeParseError(err, 3, path)
+	}
+
+	analysis, err := day3.Analyze(table, rucksacks, *compartments, *minShared, *groupSize)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("items shared by at least %d of %d compartments: %d\n", analysis.MinShared, analysis.Compartments, analysis.CompartmentSum)
+	fmt.Printf("items shared by groups of %d elves: %d\n", analysis.GroupSize, analysis.GroupSum)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func runCommand(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	day := flags.Int("day", 0, "day of the puzzle to solve")
+	part := flags.Int("part", 0, "part of the puzzle to solve (1 or 2); both parts are solved when omitted")
+	inputFile := flags.String("input", "", "path to the puzzle input, or - for stdin (default dayN/input.txt or the cached download)")
+	format := flags.String("format", formatText, "output format: text, json or tsv")
+	flags.Parse(args)
+
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	var parts []int
+	switch *part {
+	case 0:
+		parts = []int{1, 2}
+	case 1, 2:
+		parts = []int{*part}
+	default:
+		return fmt.Errorf("invalid part: %d", *part)
+	}
+
+	solver, data, err := loadPuzzle(*day, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	inputHash := sha256.Sum256(data)
+
+	var results []*result
+	for _, p := range parts {
+		start := time.Now()
+		answer, err := puzzle.Solve(solver, p)
+		if err != nil {
+			return fmt.Errorf("day %d part %d: %v", *day, p, err)
+		}
+
+		results = append(results, &result{
+			Day:       *day,
+			Part:      p,
+			Answer:    answer,
+			Duration:  time.Since(start),
+			InputHash: hex.EncodeToString(inputHash[:]),
+		})
+	}
+
+	return writeResults(os.Stdout, *format, results)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/day2"
+)
+
+func simulateCommand(args []string) error {
+	flags := flag.NewFlagSet("simulate", flag.ExitOnError)
+	strategies := flags.String("strategies", strings.Join(day2.StrategyNames(), ","), "comma separated strategies to play against each other")
+	matches := flags.Int("matches", 1000, "matches played by each pair of strategies")
+	rounds := flags.Int("rounds", 100, "rounds in each match")
+	seed := flags.Int64("seed", 1, "seed of the random number generator")
+	inputFile := flags.String("input", "", "path to the day 2 puzzle input played by the guide strategy, or - for stdin (default day2/input.txt or the cached download)")
+	gameFile := flags.String("game", "", "game definition file (default rock paper scissors)")
+	flags.Parse(args)
+
+	game, err := loadGame(*gameFile)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, name := range strings.Split(*strategies, ",") {
+		names = append(names, strings.TrimSpace(name))
+	}
+
+	var guide []day2.Shape
+	for _, name := range names {
+		if name != "guide" {
+			continue
+		}
+
+		guide, err = guideShapes(game, *inputFile)
+		if err != nil {
+			return err
+		}
+		break
+	}
+
+	simulation := &day2.Simulation{
+		Game:    game,
+		Matches: *matches,
+		Rounds:  *rounds,
+		Seed:    *seed,
+	}
+	for _, name := range names {
+		strategy, err := day2.NewStrategy(name, guide)
+		if err != nil {
+			return err
+		}
+
+		simulation.Strategies = append(simulation.Strategies, strategy)
+	}
+
+	results, err := simulation.Run()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "strategy\topponent\tmatches\twins\tlosses\tdraws\twin rate\t95% CI\tmean score\t95% CI\tmean opponent score\n")
+	for _, metrics := range results {
+		opponent := metrics.Opponent
+		if opponent == "" {
+			opponent = "all"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%.3f\t%.3f-%.3f\t%.1f\t%.1f-%.1f\t%.1f\n",
+			metrics.Strategy, opponent, metrics.Matches, metrics.Wins, metrics.Losses, metrics.Draws,
+			metrics.WinRate, metrics.WinRateCI[0], metrics.WinRateCI[1],
+			metrics.MeanScore, metrics.MeanScoreCI[0], metrics.MeanScoreCI[1], metrics.MeanOpponentScore)
+	}
+
+	return w.Flush()
+}
+
+// guideShapes returns the shapes the strategy guide's second column says to
+// play, read as shapes like in part 1 of the puzzle.
+func guideShapes(game *day2.Game, inputFile string) ([]day2.Shape, error) {
+	rounds, err := readStrategyGuide(inputFile)
+	if err != nil {
+		return nil, err
+	}
+
+	interpretation, err := day2.LookupInterpretation(game, "shape")
+	if err != nil {
+		return nil, err
+	}
+
+	opponentShapes, err := day2.OpponentShapes(game, rounds)
+	if err != nil {
+		return nil, err
+	}
+
+	shapes := make([]day2.Shape, len(rounds))
+	for idx, columns := range rounds {
+		shapes[idx], err = interpretation.PlayerShape(game, opponentShapes[idx], columns[1])
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %v", idx+1, err)
+		}
+	}
+
+	return shapes, nil
+}
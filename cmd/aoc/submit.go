@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/darthchudi/aoc2022/client"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func submitCommand(args []string) error {
+	flags := flag.NewFlagSet("submit", flag.ExitOnError)
+	day := flags.Int("day", 0, "day of the puzzle to submit an answer for")
+	part := flags.Int("part", 0, "part of the puzzle to submit an answer for (1 or 2)")
+	inputFile := flags.String("input", "", "path to the puzzle input, or - for stdin (default dayN/input.txt or the cached download)")
+	ledgerFile := flags.String("ledger", ".aoc/answers.json", "file every submitted answer and its verdict is recorded in")
+	flags.Parse(args)
+
+	if *part != 1 && *part != 2 {
+		return fmt.Errorf("invalid part: %d", *part)
+	}
+
+	solver, _, err := loadPuzzle(*day, *inputFile)
+	if err != nil {
+		return err
+	}
+
+	answer, err := puzzle.Solve(solver, *part)
+	if err != nil {
+		return fmt.Errorf("day %d part %d: %v", *day, *part, err)
+	}
+
+	ledger, err := client.LoadLedger(*ledgerFile)
+	if err != nil {
+		return fmt.Errorf("failed to load answer ledger: %v", err)
+	}
+
+	if err := client.CheckAnswer(ledger, *day, *part, answer.String()); err != nil {
+		return fmt.Errorf("not submitting: %v", err)
+	}
+
+	c, err := client.New()
+	if err != nil {
+		return err
+	}
+
+	response, err := c.Submit(*day, *part, answer.String())
+	if err != nil {
+		return err
+	}
+
+	attempt := &client.Attempt{
+		Time:    time.Now().UTC(),
+		Day:     *day,
+		Part:    *part,
+		Answer:  answer.String(),
+		Verdict: response.Verdict,
+	}
+	if err := client.AppendLedger(*ledgerFile, attempt); err != nil {
+		return fmt.Errorf("failed to save answer ledger: %v", err)
+	}
+
+	fmt.Printf("day %d part %d: %s is %s\n", *day, *part, answer, response.Verdict)
+	if response.Verdict == client.VerdictRateLimited {
+		fmt.Printf("try again in %v\n", response.Wait)
+	}
+	if response.Verdict == client.VerdictUnknown {
+		fmt.Println(response.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package day1
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+type ElfStat struct {
+	index    int
+	calories int
+	items    int
+}
+
+func init() {
+	puzzle.Register(1, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver finds the elves carrying the most calories.
+type Solver struct {
+	topElves []*RankedElf
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	// Neither part looks further than the top three elves
+	topElves, err := TopElves(r, 3)
+	if err != nil {
+		return err
+	}
+
+	s.topElves = topElves
+
+	return nil
+}
+
+// Part1 returns the most calories carried by a single elf.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	total, err := totalCalories(s.topElves, 1)
+	if err != nil {
+		return puzzle.Answer{}, fmt.Errorf("error finding top elf: %v", err)
+	}
+
+	return puzzle.Int(total), nil
+}
+
+// Part2 returns the total calories carried by the top three elves.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	total, err := totalCalories(s.topElves, 3)
+	if err != nil {
+		return puzzle.Answer{}, fmt.Errorf("error finding top elves: %v", err)
+	}
+
+	return puzzle.Int(total), nil
+}
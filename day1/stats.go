@@ -0,0 +1,188 @@
+package day1
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"text/tabwriter"
+)
+
+// reportPercentiles are the percentiles of calorie totals included in a
+// report.
+var reportPercentiles = []float64{10, 25, 50, 75, 90, 99}
+
+// Report summarises the calorie inventories of every elf.
+type Report struct {
+	Elves            int           `json:"elves"`
+	TotalCalories    int           `json:"totalCalories"`
+	Mean             float64       `json:"mean"`
+	Median           float64       `json:"median"`
+	StdDev           float64       `json:"stdDev"`
+	Percentiles      []*Percentile `json:"percentiles"`
+	CalorieHistogram []*Bucket     `json:"calorieHistogram"`
+	ItemHistogram    []*Bucket     `json:"itemHistogram"`
+
+	// Top lists the top elves along with every elf tied with the last of
+	// them, so ties at the cutoff aren't silently dropped.
+	Top []*RankedElf `json:"top"`
+}
+
+// Percentile is the calorie total below which a percentage of elves fall.
+type Percentile struct {
+	Percent  float64 `json:"percent"`
+	Calories float64 `json:"calories"`
+}
+
+// Bucket counts the elves whose value lies between Low and High inclusive.
+type Bucket struct {
+	Low   int `json:"low"`
+	High  int `json:"high"`
+	Count int `json:"count"`
+}
+
+// NewReport computes the statistics of the elves' inventories. top is the
+// number of elves to list at the top, and buckets the number of equal width
+// buckets in the calorie histogram.
+func NewReport(elfStats []*ElfStat, top, buckets int) (*Report, error) {
+	if len(elfStats) == 0 {
+		return nil, fmt.Errorf("no elves to report on")
+	}
+	if top < 1 || buckets < 1 {
+		return nil, fmt.Errorf("top and buckets must be at least 1")
+	}
+
+	calories := make([]int, len(elfStats))
+	for idx, elf := range elfStats {
+		calories[idx] = elf.calories
+	}
+	sort.Ints(calories)
+
+	report := &Report{Elves: len(elfStats)}
+	for _, c := range calories {
+		report.TotalCalories += c
+	}
+	report.Mean = float64(report.TotalCalories) / float64(len(calories))
+	report.Median = percentile(calories, 50)
+
+	var squares float64
+	for _, c := range calories {
+		squares += (float64(c) - report.Mean) * (float64(c) - report.Mean)
+	}
+	report.StdDev = math.Sqrt(squares / float64(len(calories)))
+
+	for _, p := range reportPercentiles {
+		report.Percentiles = append(report.Percentiles, &Percentile{Percent: p, Calories: percentile(calories, p)})
+	}
+
+	report.CalorieHistogram = calorieHistogram(calories, buckets)
+	report.ItemHistogram = itemHistogram(elfStats)
+	report.Top = topWithTies(elfStats, top)
+
+	return report, nil
+}
+
+// percentile interpolates linearly between the closest ranks of the sorted
+// values.
+func percentile(sorted []int, p float64) float64 {
+	position := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+
+	fraction := position - float64(lower)
+	return float64(sorted[lower]) + fraction*float64(sorted[upper]-sorted[lower])
+}
+
+func calorieHistogram(sorted []int, buckets int) []*Bucket {
+	low, high := sorted[0], sorted[len(sorted)-1]
+
+	// Round the width up so the buckets cover the whole range
+	width := (high - low + buckets) / buckets
+
+	histogram := make([]*Bucket, buckets)
+	for idx := range histogram {
+		bucketLow := low + idx*width
+		histogram[idx] = &Bucket{Low: bucketLow, High: bucketLow + width - 1}
+	}
+
+	for _, c := range sorted {
+		histogram[(c-low)/width].Count++
+	}
+
+	// Drop the empty buckets past the highest value
+	for len(histogram) > 1 && histogram[len(histogram)-1].Low > high {
+		histogram = histogram[:len(histogram)-1]
+	}
+
+	return histogram
+}
+
+func itemHistogram(elfStats []*ElfStat) []*Bucket {
+	counts := map[int]int{}
+	for _, elf := range elfStats {
+		counts[elf.items]++
+	}
+
+	var histogram []*Bucket
+	for items, count := range counts {
+		histogram = append(histogram, &Bucket{Low: items, High: items, Count: count})
+	}
+
+	sort.Slice(histogram, func(i, j int) bool {
+		return histogram[i].Low < histogram[j].Low
+	})
+
+	return histogram
+}
+
+// topWithTies returns the n elves carrying the most calories, plus any elf
+// tied with the nth.
+func topWithTies(elfStats []*ElfStat, n int) []*RankedElf {
+	sorted := make([]*ElfStat, len(elfStats))
+	copy(sorted, elfStats)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].calories > sorted[j].calories
+	})
+
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+
+	cutoff := n
+	for cutoff < len(sorted) && sorted[cutoff].calories == sorted[n-1].calories {
+		cutoff++
+	}
+
+	return rankElves(sorted[:cutoff])
+}
+
+// WriteTable writes the report as aligned plain text tables.
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "elves\t%d\n", r.Elves)
+	fmt.Fprintf(tw, "total calories\t%d\n", r.TotalCalories)
+	fmt.Fprintf(tw, "mean\t%.1f\n", r.Mean)
+	fmt.Fprintf(tw, "median\t%.1f\n", r.Median)
+	fmt.Fprintf(tw, "standard deviation\t%.1f\n", r.StdDev)
+	for _, p := range r.Percentiles {
+		fmt.Fprintf(tw, "p%g\t%.1f\n", p.Percent, p.Calories)
+	}
+
+	fmt.Fprintln(tw, "\nrank\telf\tcalories")
+	for _, elf := range r.Top {
+		fmt.Fprintf(tw, "%d\t%d\t%d\n", elf.Rank, elf.Index+1, elf.Calories)
+	}
+
+	fmt.Fprintln(tw, "\ncalories\telves")
+	for _, bucket := range r.CalorieHistogram {
+		fmt.Fprintf(tw, "%d-%d\t%d\n", bucket.Low, bucket.High, bucket.Count)
+	}
+
+	fmt.Fprintln(tw, "\nitems\telves")
+	for _, bucket := range r.ItemHistogram {
+		fmt.Fprintf(tw, "%d\t%d\n", bucket.Low, bucket.Count)
+	}
+
+	return tw.Flush()
+}
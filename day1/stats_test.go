@@ -0,0 +1,108 @@
+package day1
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const inventories = `1000
+2000
+
+4000
+
+5000
+1000
+
+6000
+
+1000
+1000
+1000
+`
+
+func TestNewReport(t *testing.T) {
+	elfStats, err := ReadElfStats(strings.NewReader(inventories))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := NewReport(elfStats, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Elves != 5 || report.TotalCalories != 22000 || report.Mean != 4400 || report.Median != 4000 {
+		t.Errorf("%d elves, %d calories, mean %g, median %g, want 5, 22000, 4400 and 4000",
+			report.Elves, report.TotalCalories, report.Mean, report.Median)
+	}
+	if want := math.Sqrt(1840000); math.Abs(report.StdDev-want) > 1e-9 {
+		t.Errorf("standard deviation = %g, want %g", report.StdDev, want)
+	}
+
+	wantPercentiles := []*Percentile{{10, 3000}, {25, 3000}, {50, 4000}, {75, 6000}, {90, 6000}, {99, 6000}}
+	if !reflect.DeepEqual(report.Percentiles, wantPercentiles) {
+		t.Errorf("percentiles = %v, want %v", report.Percentiles, wantPercentiles)
+	}
+
+	wantCalories := []*Bucket{{3000, 3750, 2}, {3751, 4501, 1}, {4502, 5252, 0}, {5253, 6003, 2}}
+	if !reflect.DeepEqual(report.CalorieHistogram, wantCalories) {
+		t.Errorf("calorie histogram = %v, want %v", report.CalorieHistogram, wantCalories)
+	}
+
+	wantItems := []*Bucket{{1, 1, 2}, {2, 2, 2}, {3, 3, 1}}
+	if !reflect.DeepEqual(report.ItemHistogram, wantItems) {
+		t.Errorf("item histogram = %v, want %v", report.ItemHistogram, wantItems)
+	}
+
+	if _, err := NewReport(nil, 3, 4); err == nil {
+		t.Error("expected an error for no elves")
+	}
+}
+
+func TestTopWithTies(t *testing.T) {
+	elfStats, err := ReadElfStats(strings.NewReader(inventories))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		n    int
+		want []*RankedElf
+	}{
+		{1, []*RankedElf{{1, 2, 6000}, {1, 3, 6000}}},
+		{3, []*RankedElf{{1, 2, 6000}, {1, 3, 6000}, {3, 1, 4000}}},
+		{4, []*RankedElf{{1, 2, 6000}, {1, 3, 6000}, {3, 1, 4000}, {4, 0, 3000}, {4, 4, 3000}}},
+		{10, []*RankedElf{{1, 2, 6000}, {1, 3, 6000}, {3, 1, 4000}, {4, 0, 3000}, {4, 4, 3000}}},
+	}
+
+	for _, test := range tests {
+		if got := topWithTies(elfStats, test.n); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("top %d = %v, want %v", test.n, got, test.want)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []int{10, 20, 30, 40}
+
+	for _, test := range []struct{ p, want float64 }{{0, 10}, {10, 13}, {50, 25}, {100, 40}} {
+		if got := percentile(sorted, test.p); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("p%g = %g, want %g", test.p, got, test.want)
+		}
+	}
+}
+
+func TestCalorieHistogram(t *testing.T) {
+	// Buckets past the highest value are dropped
+	want := []*Bucket{{1, 1, 1}, {2, 2, 2}, {3, 3, 1}}
+	if got := calorieHistogram([]int{1, 2, 2, 3}, 10); !reflect.DeepEqual(got, want) {
+		t.Errorf("histogram = %v, want %v", got, want)
+	}
+
+	want = []*Bucket{{5, 5, 3}}
+	if got := calorieHistogram([]int{5, 5, 5}, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("histogram of equal values = %v, want %v", got, want)
+	}
+}
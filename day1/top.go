@@ -0,0 +1,168 @@
+package day1
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+)
+
+// RankedElf is one of the elves carrying the most calories. An elf's rank is
+// one more than the number of elves carrying strictly more calories, so tied
+// elves share a rank and the next rank is skipped, as in 1, 2, 2, 4. Tied
+// elves are listed in input order.
+type RankedElf struct {
+	Rank     int // 1 for the elf carrying the most calories
+	Index    int // position of the elf's inventory in the input, counting from 0
+	Calories int
+}
+
+// elfHeap is a min-heap of elves, so the elf carrying the fewest calories is
+// the first to be dropped once the heap is full. Elves carrying the same
+// calories are ranked by their position in the input.
+type elfHeap []*ElfStat
+
+func (h elfHeap) Len() int { return len(h) }
+
+func (h elfHeap) Less(i, j int) bool {
+	if h[i].calories != h[j].calories {
+		return h[i].calories < h[j].calories
+	}
+
+	return h[i].index > h[j].index
+}
+
+func (h elfHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *elfHeap) Push(x interface{}) { *h = append(*h, x.(*ElfStat)) }
+
+func (h *elfHeap) Pop() interface{} {
+	old := *h
+	elf := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return elf
+}
+
+// TopElves reads calorie inventories separated by blank lines from r and
+// returns the n elves carrying the most calories, best first. Fewer elves are
+// returned if the input has fewer than n. Only the top n elves are kept in
+// memory, so the input can be arbitrarily large.
+func TopElves(r io.Reader, n int) ([]*RankedElf, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("n must be at least 1")
+	}
+
+	top := make(elfHeap, 0, n+1)
+	err := scanElves(r, func(elf *ElfStat) {
+		heap.Push(&top, elf)
+		if top.Len() > n {
+			heap.Pop(&top)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sorted := make([]*ElfStat, top.Len())
+	for i := top.Len() - 1; i >= 0; i-- {
+		sorted[i] = heap.Pop(&top).(*ElfStat)
+	}
+
+	return rankElves(sorted), nil
+}
+
+// rankElves ranks elves sorted by the calories they carry, most first.
+func rankElves(sorted []*ElfStat) []*RankedElf {
+	ranked := make([]*RankedElf, len(sorted))
+	for idx, elf := range sorted {
+		rank := idx + 1
+		if idx > 0 && elf.calories == sorted[idx-1].calories {
+			rank = ranked[idx-1].Rank
+		}
+
+		ranked[idx] = &RankedElf{Rank: rank, Index: elf.index, Calories: elf.calories}
+	}
+
+	return ranked
+}
+
+// scanElves reads calorie inventories separated by blank lines from r, calling
+// visit with each elf's totals as soon as its inventory ends.
+func scanElves(r io.Reader, visit func(elf *ElfStat)) error {
+	scanner := bufio.NewScanner(r)
+	var elf *ElfStat
+	elves := 0
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if line == "" {
+			if elf != nil {
+				visit(elf)
+				elf = nil
+			}
+			continue
+		}
+
+		value, err := strconv.Atoi(line)
+		if err != nil {
+			return &input.ParseError{
+				Day:      1,
+				Line:     lineNumber,
+				Column:   1,
+				Text:     line,
+				Expected: "the calories of a food item",
+				Err:      err,
+			}
+		}
+
+		if elf == nil {
+			elf = &ElfStat{index: elves}
+			elves++
+		}
+		elf.calories += value
+		elf.items++
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if elf != nil {
+		visit(elf)
+	}
+
+	return nil
+}
+
+// ReadElfStats reads calorie inventories separated by blank lines from r and
+// returns the totals of every elf, in input order.
+func ReadElfStats(r io.Reader) ([]*ElfStat, error) {
+	var elfStats []*ElfStat
+	err := scanElves(r, func(elf *ElfStat) {
+		elfStats = append(elfStats, elf)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return elfStats, nil
+}
+
+// totalCalories returns the calories carried by the top n ranked elves.
+func totalCalories(ranked []*RankedElf, n int) (int, error) {
+	if n > len(ranked) {
+		return 0, fmt.Errorf("n is greater than the number of elves")
+	}
+
+	var total int
+	for _, elf := range ranked[:n] {
+		total += elf.Calories
+	}
+
+	return total, nil
+}
@@ -0,0 +1,84 @@
+package day1
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// randomInventories returns the inventories of elves, with few enough
+// distinct totals that many elves are tied.
+func randomInventories(rng *rand.Rand, elves int) string {
+	var b strings.Builder
+	for elf := 0; elf < elves; elf++ {
+		if elf > 0 {
+			b.WriteString("\n")
+		}
+		for item := rng.Intn(3) + 1; item > 0; item-- {
+			fmt.Fprintf(&b, "%d\n", rng.Intn(5)+1)
+		}
+	}
+
+	return b.String()
+}
+
+// sortAndTake ranks every elf by sorting, breaking ties by input order, and
+// returns the first n.
+func sortAndTake(elfStats []*ElfStat, n int) []*ElfStat {
+	sorted := append([]*ElfStat(nil), elfStats...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].calories > sorted[j].calories
+	})
+
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+
+	return sorted[:n]
+}
+
+func TestTopElves(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for trial := 0; trial < 200; trial++ {
+		inventories := randomInventories(rng, rng.Intn(20)+1)
+		n := rng.Intn(25) + 1
+
+		elfStats, err := ReadElfStats(strings.NewReader(inventories))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := sortAndTake(elfStats, n)
+
+		got, err := TopElves(strings.NewReader(inventories), n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("%d elves, top %d: got %d elves, want %d", len(elfStats), n, len(got), len(want))
+		}
+		for idx := range want {
+			rank := 1
+			for _, elf := range elfStats {
+				if elf.calories > want[idx].calories {
+					rank++
+				}
+			}
+			if got[idx].Rank != rank {
+				t.Fatalf("top %d elf %d has rank %d, want %d", n, idx+1, got[idx].Rank, rank)
+			}
+
+			if got[idx].Index != want[idx].index || got[idx].Calories != want[idx].calories {
+				t.Fatalf("top %d elf %d is elf %d with %d calories, want elf %d with %d calories\n%s",
+					n, idx+1, got[idx].Index, got[idx].Calories, want[idx].index, want[idx].calories, inventories)
+			}
+		}
+	}
+
+	if _, err := TopElves(strings.NewReader("1\n"), 0); err == nil {
+		t.Error("expected an error for n = 0")
+	}
+}
@@ -0,0 +1,203 @@
+package day10
+
+import (
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func init() {
+	puzzle.Register(10, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver runs the program on the handheld device's CPU.
+type Solver struct {
+	instructions []*Instruction
+}
+
+type Position struct {
+	row    int
+	column int
+}
+
+type Sprite struct {
+	start int
+	end   int
+}
+
+type Instruction struct {
+	name     string
+	argument int
+}
+
+type Output struct {
+	value    string
+	position *Position
+}
+
+// Move moves the sprite based on the register count
+// The register count identifies the center position of
+// the 3px wide sprite
+func (s *Sprite) Move(registerCount int) {
+	s.start = registerCount - 1
+	s.end = registerCount + 1
+}
+
+func (o *Output) Draw(sprite *Sprite, currentCycle, registerCount int) {
+	value := "."
+
+	for i := sprite.start; i <= sprite.end; i++ {
+		if i == o.position.column {
+			value = "#"
+		}
+	}
+
+	o.value += value
+	o.position.column += 1
+
+	if currentCycle%40 == 0 {
+		// Wrap to a new row because the CRT is 40px wide and 6px high
+		o.value += "\n"
+		o.position.row += 1
+		o.position.column = 0
+
+	}
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	var instructions []*Instruction
+
+	lines, err := input.Lines(r)
+	if err != nil {
+		return err
+	}
+
+	for idx, line := range lines {
+		parts := strings.Split(line, " ")
+		instruction := &Instruction{name: parts[0]}
+
+		switch instruction.name {
+		case "addx":
+			if len(parts) != 2 {
+				return &input.ParseError{
+					Day:      10,
+					Line:     idx + 1,
+					Text:     line,
+					Expected: `a single argument after addx, like "addx -5"`,
+				}
+			}
+
+			argument, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return &input.ParseError{
+					Day:      10,
+					Line:     idx + 1,
+					Column:   len("addx ") + 1,
+					Text:     line,
+					Expected: "a number to add to the X register",
+					Err:      err,
+				}
+			}
+
+			instruction.argument = argument
+		case "noop":
+			if len(parts) != 1 {
+				return &input.ParseError{
+					Day:      10,
+					Line:     idx + 1,
+					Column:   len("noop") + 1,
+					Text:     line,
+					Expected: "no arguments after noop",
+				}
+			}
+		default:
+			return &input.ParseError{
+				Day:      10,
+				Line:     idx + 1,
+				Column:   1,
+				Text:     line,
+				Expected: "an addx or noop instruction",
+			}
+		}
+
+		instructions = append(instructions, instruction)
+	}
+
+	s.instructions = instructions
+
+	return nil
+}
+
+// Part1 returns the sum of the signal strengths during the 20th, 60th, 100th,
+// 140th, 180th and 220th cycles.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	signalStrengths, _ := runProgram(s.instructions)
+
+	sum := signalStrengths[20] + signalStrengths[60] + signalStrengths[100] + signalStrengths[140] + signalStrengths[180] + signalStrengths[220]
+	return puzzle.Int(sum), nil
+}
+
+// Part2 returns the image drawn on the CRT.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	_, output := runProgram(s.instructions)
+	return puzzle.Text(strings.TrimRight(output.value, "\n")), nil
+}
+
+// runProgram executes the instructions, returning the signal strength at
+// every 20th cycle and the image drawn on the CRT along the way.
+func runProgram(instructions []*Instruction) (map[int]int, *Output) {
+	currentCycle := 0
+	registerCount := 1
+
+	signalStrengths := map[int]int{}
+
+	output := &Output{
+		value: "",
+		position: &Position{
+			row:    0,
+			column: 0,
+		},
+	}
+
+	sprite := &Sprite{
+		start: 0,
+		end:   2,
+	}
+
+	for _, instruction := range instructions {
+		switch instruction.name {
+		case "addx":
+			// Two cycles
+			for i := 0; i < 2; i++ {
+				currentCycle += 1
+
+				output.Draw(sprite, currentCycle, registerCount)
+
+				isRelevantCycle := currentCycle%20 == 0
+				if isRelevantCycle {
+					signalStrengths[currentCycle] = currentCycle * registerCount
+				}
+			}
+
+			registerCount += instruction.argument
+			sprite.Move(registerCount)
+		case "noop":
+			// One cycle
+			for i := 0; i < 1; i++ {
+				currentCycle += 1
+
+				output.Draw(sprite, currentCycle, registerCount)
+
+				isRelevantCycle := currentCycle%20 == 0
+				if isRelevantCycle {
+					signalStrengths[currentCycle] = currentCycle * registerCount
+				}
+			}
+		}
+	}
+
+	return signalStrengths, output
+}
@@ -0,0 +1,361 @@
+package day11
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func init() {
+	puzzle.Register(11, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver works out the level of monkey business as the monkeys throw items.
+type Solver struct {
+	monkeys []*Monkey
+}
+
+type Operation struct {
+	Operator             string
+	Delta                int
+	isRecursiveOperation bool
+}
+
+type Test struct {
+	Delta  int // Delta is the amount to divide by
+	OnPass int // monkey to throw to if the test passes
+	OnFail int // monkey to throw to if the test fails
+}
+
+type Monkey struct {
+	index          int
+	items          []int
+	operation      *Operation
+	test           *Test
+	inspectedItems int
+}
+
+var (
+	monkeyRegex     = regexp.MustCompile(`^Monkey ([0-9]+):$`)
+	itemsRegex      = regexp.MustCompile(`^Starting items:\s*(.*)$`)
+	operationRegex  = regexp.MustCompile(`^Operation: new = old ([+*]) (old|[0-9]+)$`)
+	testRegex       = regexp.MustCompile(`^Test: divisible by ([0-9]+)$`)
+	onTestPassRegex = regexp.MustCompile(`^If true: throw to monkey ([0-9]+)$`)
+	onTestFailRegex = regexp.MustCompile(`^If false: throw to monkey ([0-9]+)$`)
+)
+
+func (s *Solver) Parse(r io.Reader) error {
+	var monkeys []*Monkey
+
+	// A line break separates monkeys
+	blocks, err := input.Blocks(r)
+	if err != nil {
+		return err
+	}
+
+	monkeyBlocks := map[int]*input.Block{}
+	for _, block := range blocks {
+		monkey, err := parseMonkey(block)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := monkeyBlocks[monkey.index]; ok {
+			return &input.ParseError{
+				Day:      11,
+				Line:     block.Line,
+				Text:     block.Lines[0],
+				Expected: fmt.Sprintf("a new monkey, but monkey %d is already defined", monkey.index),
+			}
+		}
+
+		monkeys = append(monkeys, monkey)
+		monkeyBlocks[monkey.index] = block
+	}
+
+	// Make sure every monkey throws to a monkey that exists
+	for _, monkey := range monkeys {
+		for _, target := range []int{monkey.test.OnPass, monkey.test.OnFail} {
+			if _, ok := monkeyBlocks[target]; !ok {
+				block := monkeyBlocks[monkey.index]
+				return &input.ParseError{
+					Day:      11,
+					Line:     block.Line,
+					Text:     block.Lines[0],
+					Expected: fmt.Sprintf("monkey %d to throw to an existing monkey, not monkey %d", monkey.index, target),
+				}
+			}
+		}
+	}
+
+	s.monkeys = monkeys
+
+	return nil
+}
+
+func parseMonkey(block *input.Block) (*Monkey, error) {
+	currentMonkey := &Monkey{}
+
+	for lineIdx, rawLine := range block.Lines {
+		line := strings.TrimSpace(rawLine)
+		indent := strings.Index(rawLine, line)
+
+		// newParseError reports a problem found at an index of the trimmed
+		// line, or with the whole line when the index is negative
+		newParseError := func(index int, expected string, err error) error {
+			column := 0
+			if index >= 0 {
+				column = indent + index + 1
+			}
+
+			return &input.ParseError{
+				Day:      11,
+				Line:     block.Line + lineIdx,
+				Column:   column,
+				Text:     rawLine,
+				Expected: expected,
+				Err:      err,
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(line, "Monkey"):
+			matches := monkeyRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, newParseError(-1, `a monkey header like "Monkey 0:"`, nil)
+			}
+
+			index, err := strconv.Atoi(matches[1])
+			if err != nil {
+				return nil, newParseError(len("Monkey "), "the monkey's index", err)
+			}
+
+			currentMonkey.index = index
+		case strings.HasPrefix(line, "Starting items:"):
+			matches := itemsRegex.FindStringSubmatch(line)
+			if matches[1] == "" {
+				// The monkey starts without any items
+				continue
+			}
+			itemsStringSlice := strings.Split(matches[1], ", ")
+
+			offset := len(line) - len(matches[1])
+			for _, itemString := range itemsStringSlice {
+				item, err := strconv.Atoi(itemString)
+				if err != nil {
+					return nil, newParseError(offset, "the worry level of an item", err)
+				}
+				currentMonkey.items = append(currentMonkey.items, item)
+
+				offset += len(itemString) + len(", ")
+			}
+		case strings.HasPrefix(line, "Operation:"):
+			matches := operationRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, newParseError(-1, `an operation like "Operation: new = old * 19"`, nil)
+			}
+			matches = matches[1:]
+
+			operator, deltaStr := matches[0], matches[1]
+			delta := 0
+			isRecursiveOperation := false
+
+			switch deltaStr {
+			case "old":
+				isRecursiveOperation = true
+			default:
+				var err error
+				delta, err = strconv.Atoi(deltaStr)
+				if err != nil {
+					return nil, newParseError(len("Operation: new = old * "), "the operation's operand", err)
+				}
+			}
+
+			currentMonkey.operation = &Operation{
+				Operator:             operator,
+				Delta:                delta,
+				isRecursiveOperation: isRecursiveOperation,
+			}
+		case strings.HasPrefix(line, "Test:"):
+			matches := testRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, newParseError(-1, `a test like "Test: divisible by 23"`, nil)
+			}
+			matches = matches[1:]
+
+			delta, err := strconv.Atoi(matches[0])
+			if err != nil || delta == 0 {
+				return nil, newParseError(len("Test: divisible by "), "a divisor greater than zero", err)
+			}
+
+			currentMonkey.test = &Test{
+				Delta:  delta,
+				OnPass: 0,
+				OnFail: 0,
+			}
+		case strings.HasPrefix(line, "If true:"):
+			matches := onTestPassRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, newParseError(-1, `an action like "If true: throw to monkey 2"`, nil)
+			}
+			matches = matches[1:]
+
+			value, err := strconv.Atoi(matches[0])
+			if err != nil {
+				return nil, newParseError(len("If true: throw to monkey "), "the monkey to throw to", err)
+			}
+
+			if currentMonkey.test == nil {
+				return nil, newParseError(-1, "the monkey's test before what happens when it passes", nil)
+			}
+
+			currentMonkey.test.OnPass = value
+		case strings.HasPrefix(line, "If false:"):
+			matches := onTestFailRegex.FindStringSubmatch(line)
+			if matches == nil {
+				return nil, newParseError(-1, `an action like "If false: throw to monkey 3"`, nil)
+			}
+			matches = matches[1:]
+
+			value, err := strconv.Atoi(matches[0])
+			if err != nil {
+				return nil, newParseError(len("If false: throw to monkey "), "the monkey to throw to", err)
+			}
+
+			if currentMonkey.test == nil {
+				return nil, newParseError(-1, "the monkey's test before what happens when it fails", nil)
+			}
+
+			currentMonkey.test.OnFail = value
+		default:
+			return nil, newParseError(0, "one of the monkey's notes", nil)
+		}
+	}
+
+	if currentMonkey.operation == nil || currentMonkey.test == nil {
+		return nil, &input.ParseError{
+			Day:      11,
+			Line:     block.Line,
+			Text:     block.Lines[0],
+			Expected: "a monkey with an operation and a test",
+		}
+	}
+
+	return currentMonkey, nil
+}
+
+// Part1 returns the level of monkey business after 20 rounds, with the
+// worry level dropping after each inspection.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	monkeyBusiness, err := getMonkeyBusiness(s.monkeys, 20, true)
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	return puzzle.Int(monkeyBusiness), nil
+}
+
+// Part2 returns the level of monkey business after 10000 rounds.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	monkeyBusiness, err := getMonkeyBusiness(s.monkeys, 10000, false)
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	return puzzle.Int(monkeyBusiness), nil
+}
+
+// getMonkeyBusiness plays the rounds on a copy of the monkeys and returns the
+// product of the number of items inspected by the two most active monkeys.
+func getMonkeyBusiness(initialMonkeys []*Monkey, rounds int, isPartOne bool) (int, error) {
+	var monkeys []*Monkey
+	monkeyMap := map[int]*Monkey{}
+	for _, initialMonkey := range initialMonkeys {
+		monkey := &Monkey{
+			index:     initialMonkey.index,
+			items:     append([]int{}, initialMonkey.items...),
+			operation: initialMonkey.operation,
+			test:      initialMonkey.test,
+		}
+
+		monkeys = append(monkeys, monkey)
+		monkeyMap[monkey.index] = monkey
+	}
+
+	dividend := 1
+	for _, monkey := range monkeys {
+		dividend = dividend * monkey.test.Delta
+	}
+
+	for i := 0; i < rounds; i++ {
+		for _, monkey := range monkeys {
+			// On each round, inspect all items belonging to
+			// a monkey and carry out an operation on the items
+			// given their worry level
+			for _, item := range monkey.items {
+				worryLevel := item
+
+				delta := monkey.operation.Delta
+				if monkey.operation.isRecursiveOperation {
+					delta = worryLevel
+				}
+
+				switch monkey.operation.Operator {
+				case "+":
+					worryLevel = worryLevel + delta
+				case "*":
+					worryLevel = worryLevel * delta
+				default:
+					return 0, fmt.Errorf("invalid monkey operator: %v", monkey.operation.Operator)
+				}
+
+				if isPartOne {
+					// After a monkey inspects an item, the worry level is divided by 3
+					// and rounded down to the nearest integer
+					worryLevel = int(math.Floor(float64(worryLevel) / 3))
+				} else {
+					worryLevel = worryLevel % dividend
+				}
+
+				// Carry out the monkey's test to determine which monkey
+				// to throw the item to
+				nextItemLocation := -1
+				testPassed := (worryLevel % monkey.test.Delta) == 0
+				if testPassed {
+					nextItemLocation = monkey.test.OnPass
+				} else {
+					nextItemLocation = monkey.test.OnFail
+				}
+
+				// Throw the item with the new worry level to the next monkey
+				nextMonkey := monkeyMap[nextItemLocation]
+				nextMonkey.items = append(nextMonkey.items, worryLevel)
+
+				// Update the number of inspected items
+				monkey.inspectedItems += 1
+			}
+
+			// Reset the monkey's items after each round as
+			// it throws all items to the next monkey after testing
+			monkey.items = []int{}
+		}
+	}
+
+	sort.Slice(monkeys, func(i, j int) bool {
+		return monkeys[i].inspectedItems > monkeys[j].inspectedItems
+	})
+
+	if len(monkeys) < 2 {
+		return 0, fmt.Errorf("need at least two monkeys, got %d", len(monkeys))
+	}
+
+	monkeyBusiness := monkeys[0].inspectedItems * monkeys[1].inspectedItems
+	return monkeyBusiness, nil
+}
@@ -0,0 +1,234 @@
+package day2
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+type Shape int
+
+type DesiredOutcome string
+
+type Outcome string
+
+type GameMetrics struct {
+	playerScore   int
+	opponentScore int
+	rounds        []*RoundResult
+}
+
+const (
+	ShapeRock     Shape = 1
+	ShapePaper    Shape = 2
+	ShapeScissors Shape = 3
+
+	DesiredOutcomeLose DesiredOutcome = "lose"
+	DesiredOutcomeDraw DesiredOutcome = "draw"
+	DesiredOutcomeWin  DesiredOutcome = "win"
+
+	OutcomePlayer   Outcome = "player"
+	OutcomeOpponent Outcome = "opponent"
+	OutcomeDraw     Outcome = "draw"
+
+	OutcomeScoreLost = 0
+	OutcomeScoreDraw = 3
+	OutcomeScoreWin  = 6
+)
+
+func init() {
+	puzzle.Register(2, func() puzzle.Solver { return &Solver{game: DefaultGame()} })
+}
+
+// Solver scores a rock paper scissors strategy guide.
+type Solver struct {
+	game   *Game
+	rounds [][]string // the columns of each round in the strategy guide
+}
+
+// strategyGuideShapes are the names of the shapes the first column of the
+// strategy guide stands for.
+var strategyGuideShapes = map[string]string{
+	"A": "Rock",
+	"B": "Paper",
+	"C": "Scissors",
+}
+
+// getStrategyGuide maps the first column of the strategy guide to the
+// opponent's shape in the game, looking the shapes up by name since a game
+// may define them in any order.
+func getStrategyGuide(game *Game) (map[string]Shape, error) {
+	strategyGuide := make(map[string]Shape, len(strategyGuideShapes))
+	for column, name := range strategyGuideShapes {
+		shape, ok := game.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("the strategy guide's %s stands for %s, which the game doesn't have", column, name)
+		}
+
+		strategyGuide[column] = shape
+	}
+
+	return strategyGuide, nil
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	rounds, err := ReadStrategyGuide(r)
+	if err != nil {
+		return err
+	}
+
+	s.rounds = rounds
+
+	return nil
+}
+
+// ReadStrategyGuide returns the two columns of every round in a strategy
+// guide.
+func ReadStrategyGuide(r io.Reader) ([][]string, error) {
+	gameRounds, err := input.Lines(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input: %v", err)
+	}
+
+	var rounds [][]string
+	for idx, round := range gameRounds {
+		shapes := strings.Split(round, " ")
+		if len(shapes) != 2 {
+			return nil, &input.ParseError{
+				Day:      2,
+				Line:     idx + 1,
+				Text:     round,
+				Expected: "two columns separated by a single space, like \"A Y\"",
+			}
+		}
+
+		switch shapes[0] {
+		case "A", "B", "C":
+		default:
+			return nil, &input.ParseError{
+				Day:      2,
+				Line:     idx + 1,
+				Column:   1,
+				Text:     round,
+				Expected: "an opponent shape of A, B or C",
+			}
+		}
+
+		switch shapes[1] {
+		case "X", "Y", "Z":
+		default:
+			return nil, &input.ParseError{
+				Day:      2,
+				Line:     idx + 1,
+				Column:   len(shapes[0]) + 2,
+				Text:     round,
+				Expected: "a response of X, Y or Z",
+			}
+		}
+
+		rounds = append(rounds, shapes)
+	}
+
+	return rounds, nil
+}
+
+// Part1 returns the player's score when the second column of the strategy
+// guide is the shape to play.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	return s.score("shape")
+}
+
+// Part2 returns the player's score when the second column of the strategy
+// guide is the desired outcome of the round.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	return s.score("outcome")
+}
+
+func (s *Solver) score(interpretationName string) (puzzle.Answer, error) {
+	interpretation, err := LookupInterpretation(s.game, interpretationName)
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	gameMetrics, err := PlayStrategyGuide(s.game, s.rounds, interpretation, nil)
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	return puzzle.Int(gameMetrics.playerScore), nil
+}
+
+// PlayStrategyGuide plays every round of the strategy guide, reading the
+// second column of each round with the interpretation. The result of every
+// round is written to sink as it is played, unless sink is nil.
+func PlayStrategyGuide(game *Game, gameRounds [][]string, interpretation *Interpretation, sink RoundSink) (*GameMetrics, error) {
+	strategyGuide, err := getStrategyGuide(game)
+	if err != nil {
+		return nil, err
+	}
+
+	gameMetrics := &GameMetrics{}
+
+	for idx, shapes := range gameRounds {
+		opponentShape := strategyGuide[shapes[0]]
+		playerShape, err := interpretation.PlayerShape(game, opponentShape, shapes[1])
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %v", idx+1, err)
+		}
+
+		result, err := gameMetrics.play(game, opponentShape, playerShape)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %v", idx+1, err)
+		}
+
+		if sink != nil {
+			if err := sink.Write(result); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return gameMetrics, nil
+}
+
+// play scores a round and adds it to the metrics.
+func (m *GameMetrics) play(game *Game, opponentShape, playerShape Shape) (*RoundResult, error) {
+	opponentScore, playerScore, outcome, err := game.RoundScores(opponentShape, playerShape)
+	if err != nil {
+		return nil, err
+	}
+
+	m.opponentScore += opponentScore
+	m.playerScore += playerScore
+
+	result := &RoundResult{
+		Index:         len(m.rounds),
+		OpponentShape: opponentShape,
+		PlayerShape:   playerShape,
+		Outcome:       outcome,
+		PlayerScore:   playerScore,
+		OpponentScore: opponentScore,
+		Cumulative:    m.playerScore,
+	}
+	m.rounds = append(m.rounds, result)
+
+	return result, nil
+}
+
+// PlayerScore returns the player's total score.
+func (m *GameMetrics) PlayerScore() int {
+	return m.playerScore
+}
+
+// OpponentScore returns the opponent's total score.
+func (m *GameMetrics) OpponentScore() int {
+	return m.opponentScore
+}
+
+// Rounds returns the result of every round, in the order they were played.
+func (m *GameMetrics) Rounds() []*RoundResult {
+	return m.rounds
+}
@@ -0,0 +1,90 @@
+package day2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStrategyGuideShapeOrder(t *testing.T) {
+	// Rock, Paper and Scissors aren't the first three shapes of this game,
+	// so the opponent's A, B and C have to be found by name
+	game, err := NewGame([]*ShapeDefinition{
+		{Name: "Rock", Score: 1},
+		{Name: "Spock", Score: 2},
+		{Name: "Paper", Score: 3},
+		{Name: "Lizard", Score: 4},
+		{Name: "Scissors", Score: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rounds, err := ReadStrategyGuide(strings.NewReader("A Y\nB X\nC Z\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opponentShapes, err := OpponentShapes(game, rounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx, want := range []string{"Rock", "Paper", "Scissors"} {
+		if got := game.Name(opponentShapes[idx]); got != want {
+			t.Errorf("round %d: opponent plays %s, want %s", idx+1, got, want)
+		}
+	}
+
+	interpretation, err := LookupInterpretation(game, "shape")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Paper beats Rock for 3+6, Rock loses to Paper for 1 and Scissors draws
+	// with Scissors for 5+3
+	metrics, err := PlayStrategyGuide(game, rounds, interpretation, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := metrics.PlayerScore(); got != 18 {
+		t.Errorf("PlayStrategyGuide() score = %d, want 18", got)
+	}
+
+	comparison, err := CompareInterpretations(game, rounds, []*Interpretation{interpretation})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := comparison.Scores[0]; got != 18 {
+		t.Errorf("CompareInterpretations() score = %d, want 18", got)
+	}
+}
+
+func TestStrategyGuideMissingShape(t *testing.T) {
+	game, err := NewGame([]*ShapeDefinition{
+		{Name: "Rock", Score: 1},
+		{Name: "Paper", Score: 2},
+		{Name: "Lizard", Score: 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rounds, err := ReadStrategyGuide(strings.NewReader("A X\nC X\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interpretation, err := ParseInterpretation(game, "mine", "X=Rock,Y=Paper,Z=Lizard")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpponentShapes(game, rounds); err == nil {
+		t.Error("OpponentShapes() expected an error for a game without Scissors")
+	}
+	if _, err := PlayStrategyGuide(game, rounds, interpretation, nil); err == nil {
+		t.Error("PlayStrategyGuide() expected an error for a game without Scissors")
+	}
+	if _, err := CompareInterpretations(game, rounds, []*Interpretation{interpretation}); err == nil {
+		t.Error("CompareInterpretations() expected an error for a game without Scissors")
+	}
+}
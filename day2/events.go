@@ -0,0 +1,164 @@
+package day2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// RoundResult is the result of one round of a game.
+type RoundResult struct {
+	Index         int // counting from 0
+	OpponentShape Shape
+	PlayerShape   Shape
+	Outcome       Outcome
+	PlayerScore   int
+	OpponentScore int
+	Cumulative    int // player's total score after the round
+}
+
+// RoundSink receives the result of every round as it is played.
+type RoundSink interface {
+	Write(result *RoundResult) error
+}
+
+// roundRecord is how a round result is written out, with shapes by name.
+type roundRecord struct {
+	Index         int     `json:"index"`
+	OpponentShape string  `json:"opponentShape"`
+	PlayerShape   string  `json:"playerShape"`
+	Outcome       Outcome `json:"outcome"`
+	PlayerScore   int     `json:"playerScore"`
+	OpponentScore int     `json:"opponentScore"`
+	Cumulative    int     `json:"cumulative"`
+}
+
+// JSONLinesSink writes every round result as a JSON object on its own line.
+type JSONLinesSink struct {
+	game    *Game
+	encoder *json.Encoder
+}
+
+// NewJSONLinesSink returns a sink writing to w, naming shapes after the
+// game's shapes.
+func NewJSONLinesSink(w io.Writer, game *Game) *JSONLinesSink {
+	return &JSONLinesSink{game: game, encoder: json.NewEncoder(w)}
+}
+
+func (s *JSONLinesSink) Write(result *RoundResult) error {
+	return s.encoder.Encode(&roundRecord{
+		Index:         result.Index,
+		OpponentShape: s.game.Name(result.OpponentShape),
+		PlayerShape:   s.game.Name(result.PlayerShape),
+		Outcome:       result.Outcome,
+		PlayerScore:   result.PlayerScore,
+		OpponentScore: result.OpponentScore,
+		Cumulative:    result.Cumulative,
+	})
+}
+
+// CSVSink writes every round result as a CSV row, after a header row. Call
+// Flush once every round has been written.
+type CSVSink struct {
+	game          *Game
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVSink returns a sink writing to w, naming shapes after the game's
+// shapes.
+func NewCSVSink(w io.Writer, game *Game) *CSVSink {
+	return &CSVSink{game: game, writer: csv.NewWriter(w)}
+}
+
+func (s *CSVSink) Write(result *RoundResult) error {
+	if !s.headerWritten {
+		header := []string{"index", "opponentShape", "playerShape", "outcome", "playerScore", "opponentScore", "cumulative"}
+		if err := s.writer.Write(header); err != nil {
+			return err
+		}
+		s.headerWritten = true
+	}
+
+	return s.writer.Write([]string{
+		strconv.Itoa(result.Index),
+		s.game.Name(result.OpponentShape),
+		s.game.Name(result.PlayerShape),
+		string(result.Outcome),
+		strconv.Itoa(result.PlayerScore),
+		strconv.Itoa(result.OpponentScore),
+		strconv.Itoa(result.Cumulative),
+	})
+}
+
+// Flush writes any buffered rows.
+func (s *CSVSink) Flush() error {
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+// RoundSummary describes the shape of a game beyond its final score.
+type RoundSummary struct {
+	Rounds            int
+	LongestWinStreak  int
+	LongestLossStreak int
+	ByOpponentShape   []*ShapeRecord // in the order of the game's shapes
+}
+
+// ShapeRecord is how the player fared against one of the opponent's shapes.
+type ShapeRecord struct {
+	Shape   Shape
+	Rounds  int
+	Wins    int
+	WinRate float64
+}
+
+// Summary returns the longest winning and losing streaks of the player and
+// the player's win rate against each of the opponent's shapes.
+func (m *GameMetrics) Summary(game *Game) *RoundSummary {
+	summary := &RoundSummary{Rounds: len(m.rounds)}
+
+	records := map[Shape]*ShapeRecord{}
+	for _, shape := range game.Shapes() {
+		records[shape] = &ShapeRecord{Shape: shape}
+		summary.ByOpponentShape = append(summary.ByOpponentShape, records[shape])
+	}
+
+	winStreak, lossStreak := 0, 0
+	for _, result := range m.rounds {
+		switch result.Outcome {
+		case OutcomePlayer:
+			winStreak, lossStreak = winStreak+1, 0
+		case OutcomeOpponent:
+			winStreak, lossStreak = 0, lossStreak+1
+		default:
+			winStreak, lossStreak = 0, 0
+		}
+
+		if winStreak > summary.LongestWinStreak {
+			summary.LongestWinStreak = winStreak
+		}
+		if lossStreak > summary.LongestLossStreak {
+			summary.LongestLossStreak = lossStreak
+		}
+
+		record, ok := records[result.OpponentShape]
+		if !ok {
+			continue
+		}
+
+		record.Rounds++
+		if result.Outcome == OutcomePlayer {
+			record.Wins++
+		}
+	}
+
+	for _, record := range summary.ByOpponentShape {
+		if record.Rounds > 0 {
+			record.WinRate = float64(record.Wins) / float64(record.Rounds)
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,133 @@
+package day2
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// playRounds plays rounds given as pairs of the player's and the opponent's
+// shapes.
+func playRounds(t *testing.T, game *Game, rounds [][2]Shape) *GameMetrics {
+	t.Helper()
+
+	gameMetrics := &GameMetrics{}
+	for _, round := range rounds {
+		if _, err := gameMetrics.play(game, round[1], round[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return gameMetrics
+}
+
+func TestSummary(t *testing.T) {
+	game := DefaultGame()
+
+	// Draws end both winning and losing streaks, so neither is longer than 2
+	gameMetrics := playRounds(t, game, [][2]Shape{
+		{ShapePaper, ShapeRock},        // win
+		{ShapeRock, ShapeScissors},     // win
+		{ShapeRock, ShapeRock},         // draw
+		{ShapeScissors, ShapePaper},    // win
+		{ShapeRock, ShapePaper},        // loss
+		{ShapePaper, ShapeScissors},    // loss
+		{ShapePaper, ShapePaper},       // draw
+		{ShapeScissors, ShapeRock},     // loss
+		{ShapeScissors, ShapeScissors}, // draw
+	})
+
+	summary := gameMetrics.Summary(game)
+
+	want := &RoundSummary{
+		Rounds:            9,
+		LongestWinStreak:  2,
+		LongestLossStreak: 2,
+		ByOpponentShape: []*ShapeRecord{
+			{Shape: ShapeRock, Rounds: 3, Wins: 1, WinRate: 1.0 / 3},
+			{Shape: ShapePaper, Rounds: 3, Wins: 1, WinRate: 1.0 / 3},
+			{Shape: ShapeScissors, Rounds: 3, Wins: 1, WinRate: 1.0 / 3},
+		},
+	}
+	if !reflect.DeepEqual(summary, want) {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+
+	// The opponent never plays Paper, and the player wins every round against
+	// Scissors
+	summary = playRounds(t, game, [][2]Shape{
+		{ShapeRock, ShapeScissors},
+		{ShapeRock, ShapeScissors},
+		{ShapeScissors, ShapeRock},
+	}).Summary(game)
+
+	if summary.LongestWinStreak != 2 || summary.LongestLossStreak != 1 {
+		t.Errorf("streaks = %d and %d, want 2 and 1", summary.LongestWinStreak, summary.LongestLossStreak)
+	}
+	for _, record := range summary.ByOpponentShape {
+		want := map[Shape]float64{ShapeRock: 0, ShapePaper: 0, ShapeScissors: 1}[record.Shape]
+		if record.WinRate != want {
+			t.Errorf("win rate against %s = %g, want %g", game.Name(record.Shape), record.WinRate, want)
+		}
+	}
+}
+
+func TestSinks(t *testing.T) {
+	game := DefaultGame()
+	gameMetrics := playRounds(t, game, [][2]Shape{
+		{ShapePaper, ShapeRock},
+		{ShapeRock, ShapePaper},
+	})
+
+	var csvOutput bytes.Buffer
+	csvSink := NewCSVSink(&csvOutput, game)
+	var jsonOutput bytes.Buffer
+	jsonSink := NewJSONLinesSink(&jsonOutput, game)
+
+	for _, result := range gameMetrics.Rounds() {
+		if err := csvSink.Write(result); err != nil {
+			t.Fatal(err)
+		}
+		if err := jsonSink.Write(result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := csvSink.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantCSV := `index,opponentShape,playerShape,outcome,playerScore,opponentScore,cumulative
+0,Rock,Paper,player,8,1,8
+1,Paper,Rock,opponent,1,8,9
+`
+	if csvOutput.String() != wantCSV {
+		t.Errorf("CSV output:\n%s\nwant:\n%s", csvOutput.String(), wantCSV)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(jsonOutput.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("%d JSON lines, want 2", len(lines))
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	var fields []string
+	for field := range record {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	wantFields := []string{"cumulative", "index", "opponentScore", "opponentShape", "outcome", "playerScore", "playerShape"}
+	if !reflect.DeepEqual(fields, wantFields) {
+		t.Errorf("JSON fields = %v, want %v", fields, wantFields)
+	}
+	if record["opponentShape"] != "Paper" || record["outcome"] != "opponent" || record["cumulative"] != 9.0 {
+		t.Errorf("JSON record = %v", record)
+	}
+}
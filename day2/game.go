@@ -0,0 +1,236 @@
+package day2
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Game is a cyclic game like rock paper scissors, played with an ordered set
+// of shapes. Shapes are numbered from 1 in the order they were defined, so
+// ShapeRock, ShapePaper and ShapeScissors are the shapes of the default game.
+type Game struct {
+	shapes []*ShapeDefinition
+	beats  [][]bool // beats[a][b] is true if shape a+1 beats shape b+1
+}
+
+// ShapeDefinition describes one shape of a game. When no shape lists what it
+// beats, every shape beats the (N-1)/2 shapes before it in the cyclic order,
+// as Paper beats Rock and Rock beats Scissors.
+type ShapeDefinition struct {
+	Name  string   `json:"name"`
+	Score int      `json:"score"`
+	Beats []string `json:"beats,omitempty"`
+}
+
+// GameDefinition is the format of a game definition file.
+type GameDefinition struct {
+	Shapes []*ShapeDefinition `json:"shapes"`
+}
+
+// DefaultGame returns the rock paper scissors game from the puzzle.
+func DefaultGame() *Game {
+	game, err := NewGame([]*ShapeDefinition{
+		{Name: "Rock", Score: 1},
+		{Name: "Paper", Score: 2},
+		{Name: "Scissors", Score: 3},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return game
+}
+
+// LoadGame reads a game from a JSON definition file such as:
+//
+//	{"shapes": [
+//		{"name": "Rock", "score": 1},
+//		{"name": "Spock", "score": 5},
+//		{"name": "Paper", "score": 2},
+//		{"name": "Lizard", "score": 4},
+//		{"name": "Scissors", "score": 3}
+//	]}
+func LoadGame(r io.Reader) (*Game, error) {
+	var definition GameDefinition
+	if err := json.NewDecoder(r).Decode(&definition); err != nil {
+		return nil, fmt.Errorf("invalid game definition: %v", err)
+	}
+
+	return NewGame(definition.Shapes)
+}
+
+// NewGame returns a game played with the given shapes. Every pair of distinct
+// shapes must have exactly one winner, so every round has an outcome.
+func NewGame(shapes []*ShapeDefinition) (*Game, error) {
+	n := len(shapes)
+	if n < 3 {
+		return nil, fmt.Errorf("a game needs at least 3 shapes, got %d", n)
+	}
+
+	indices := map[string]int{}
+	explicit := false
+	for idx, shape := range shapes {
+		if shape.Name == "" {
+			return nil, fmt.Errorf("shape %d has no name", idx+1)
+		}
+		if _, ok := indices[shape.Name]; ok {
+			return nil, fmt.Errorf("duplicate shape %q", shape.Name)
+		}
+
+		indices[shape.Name] = idx
+		explicit = explicit || len(shape.Beats) > 0
+	}
+
+	beats := make([][]bool, n)
+	for idx := range beats {
+		beats[idx] = make([]bool, n)
+	}
+
+	if explicit {
+		for idx, shape := range shapes {
+			for _, name := range shape.Beats {
+				beaten, ok := indices[name]
+				if !ok {
+					return nil, fmt.Errorf("%s beats unknown shape %q", shape.Name, name)
+				}
+				if beaten == idx {
+					return nil, fmt.Errorf("%s can't beat itself", shape.Name)
+				}
+
+				beats[idx][beaten] = true
+			}
+		}
+	} else {
+		if n%2 == 0 {
+			return nil, fmt.Errorf("a cyclic game needs an odd number of shapes, got %d", n)
+		}
+
+		for idx := range shapes {
+			for offset := 1; offset <= (n-1)/2; offset++ {
+				beats[idx][(idx-offset+n)%n] = true
+			}
+		}
+	}
+
+	for a := 0; a < n; a++ {
+		for b := a + 1; b < n; b++ {
+			if beats[a][b] == beats[b][a] {
+				return nil, fmt.Errorf("exactly one of %s and %s must beat the other", shapes[a].Name, shapes[b].Name)
+			}
+		}
+	}
+
+	return &Game{shapes: shapes, beats: beats}, nil
+}
+
+// Shapes returns every shape of the game, in order.
+func (g *Game) Shapes() []Shape {
+	shapes := make([]Shape, len(g.shapes))
+	for idx := range shapes {
+		shapes[idx] = Shape(idx + 1)
+	}
+
+	return shapes
+}
+
+func (g *Game) valid(shape Shape) bool {
+	return shape >= 1 && int(shape) <= len(g.shapes)
+}
+
+// Name returns the name of a shape, or an empty string if the game has no
+// such shape.
+func (g *Game) Name(shape Shape) string {
+	if !g.valid(shape) {
+		return ""
+	}
+
+	return g.shapes[shape-1].Name
+}
+
+// Lookup returns the shape with the given name.
+func (g *Game) Lookup(name string) (Shape, bool) {
+	for idx, shape := range g.shapes {
+		if shape.Name == name {
+			return Shape(idx + 1), true
+		}
+	}
+
+	return 0, false
+}
+
+// Score returns the score for playing a shape.
+func (g *Game) Score(shape Shape) int {
+	return g.shapes[shape-1].Score
+}
+
+// Outcome returns who wins a round.
+func (g *Game) Outcome(opponentShape Shape, playerShape Shape) (Outcome, error) {
+	if !g.valid(opponentShape) || !g.valid(playerShape) {
+		return "", fmt.Errorf("invalid shapes: %v and %v", opponentShape, playerShape)
+	}
+
+	switch {
+	case opponentShape == playerShape:
+		return OutcomeDraw, nil
+	case g.beats[playerShape-1][opponentShape-1]:
+		return OutcomePlayer, nil
+	default:
+		return OutcomeOpponent, nil
+	}
+}
+
+// Resolve returns the shape the player should play against the opponent's
+// shape to get the desired outcome. When several shapes would do, the one
+// with the highest score is played.
+func (g *Game) Resolve(opponentShape Shape, desiredOutcome DesiredOutcome) (Shape, error) {
+	want := map[DesiredOutcome]Outcome{
+		DesiredOutcomeLose: OutcomeOpponent,
+		DesiredOutcomeDraw: OutcomeDraw,
+		DesiredOutcomeWin:  OutcomePlayer,
+	}[desiredOutcome]
+	if want == "" {
+		return 0, fmt.Errorf("invalid desired outcome: %q", desiredOutcome)
+	}
+
+	var best Shape
+	for _, shape := range g.Shapes() {
+		outcome, err := g.Outcome(opponentShape, shape)
+		if err != nil {
+			return 0, err
+		}
+
+		if outcome == want && (best == 0 || g.Score(shape) > g.Score(best)) {
+			best = shape
+		}
+	}
+
+	if best == 0 {
+		return 0, fmt.Errorf("no shape can %s against %s", desiredOutcome, g.Name(opponentShape))
+	}
+
+	return best, nil
+}
+
+// RoundScores returns the scores of both sides for a round.
+func (g *Game) RoundScores(opponentShape Shape, playerShape Shape) (opponentScore, playerScore int, outcome Outcome, err error) {
+	outcome, err = g.Outcome(opponentShape, playerShape)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	opponentScore, playerScore = g.Score(opponentShape), g.Score(playerShape)
+	switch outcome {
+	case OutcomeOpponent:
+		opponentScore += OutcomeScoreWin
+		playerScore += OutcomeScoreLost
+	case OutcomePlayer:
+		playerScore += OutcomeScoreWin
+		opponentScore += OutcomeScoreLost
+	case OutcomeDraw:
+		playerScore += OutcomeScoreDraw
+		opponentScore += OutcomeScoreDraw
+	}
+
+	return opponentScore, playerScore, outcome, nil
+}
@@ -0,0 +1,148 @@
+package day2
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// checkOutcomes checks every round of a game against a matrix of outcomes,
+// with a row for each of the player's shapes and a column for each of the
+// opponent's: W if the player wins, L if they lose and D for a draw.
+func checkOutcomes(t *testing.T, game *Game, matrix []string) {
+	t.Helper()
+
+	outcomes := map[byte]Outcome{'W': OutcomePlayer, 'L': OutcomeOpponent, 'D': OutcomeDraw}
+	for p, row := range matrix {
+		for o := range row {
+			player, opponent := Shape(p+1), Shape(o+1)
+
+			got, err := game.Outcome(opponent, player)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := outcomes[row[o]]; got != want {
+				t.Errorf("%s against %s: %s wins, want %s", game.Name(player), game.Name(opponent), got, want)
+			}
+		}
+	}
+}
+
+func TestDefaultGame(t *testing.T) {
+	game := DefaultGame()
+
+	checkOutcomes(t, game, []string{
+		"DLW", // Rock
+		"WDL", // Paper
+		"LWD", // Scissors
+	})
+
+	for _, test := range []struct {
+		opponent Shape
+		desired  DesiredOutcome
+		want     Shape
+	}{
+		{ShapeRock, DesiredOutcomeLose, ShapeScissors},
+		{ShapeRock, DesiredOutcomeDraw, ShapeRock},
+		{ShapeRock, DesiredOutcomeWin, ShapePaper},
+		{ShapeScissors, DesiredOutcomeWin, ShapeRock},
+	} {
+		if got, err := game.Resolve(test.opponent, test.desired); err != nil || got != test.want {
+			t.Errorf("%s against %s = %s, %v, want %s", test.desired, game.Name(test.opponent), game.Name(got), err, game.Name(test.want))
+		}
+	}
+
+	if _, err := game.Resolve(ShapeRock, "tie"); err == nil {
+		t.Error("expected an error for an unknown desired outcome")
+	}
+	if _, err := game.Outcome(ShapeRock, Shape(4)); err == nil {
+		t.Error("expected an error for a shape outside the game")
+	}
+}
+
+func TestLoadGameRPSLS(t *testing.T) {
+	file, err := os.Open("games/rpsls.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	game, err := LoadGame(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkOutcomes(t, game, []string{
+		"DLWWL", // Rock
+		"WDLLW", // Paper
+		"LWDWL", // Scissors
+		"LWLDW", // Lizard
+		"WLWLD", // Spock
+	})
+
+	// Paper and Spock both beat Rock, and Spock scores more
+	rock, _ := game.Lookup("Rock")
+	spock, _ := game.Lookup("Spock")
+	if got, err := game.Resolve(rock, DesiredOutcomeWin); err != nil || got != spock {
+		t.Errorf("win against Rock = %s, %v, want Spock", game.Name(got), err)
+	}
+}
+
+func TestCyclicGame(t *testing.T) {
+	// Without beats lists, each shape beats the two before it
+	game, err := NewGame([]*ShapeDefinition{
+		{Name: "A", Score: 1},
+		{Name: "B", Score: 2},
+		{Name: "C", Score: 3},
+		{Name: "D", Score: 4},
+		{Name: "E", Score: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkOutcomes(t, game, []string{
+		"DLLWW", // A beats E and D
+		"WDLLW", // B beats A and E
+		"WWDLL", // C beats B and A
+		"LWWDL", // D beats C and B
+		"LLWWD", // E beats D and C
+	})
+}
+
+func TestNewGameErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		want       string
+	}{
+		{"too few shapes", `{"shapes": [{"name": "Rock"}, {"name": "Paper"}]}`, "at least 3 shapes"},
+		{"unnamed shape", `{"shapes": [{"name": "Rock"}, {"name": ""}, {"name": "Scissors"}]}`, "has no name"},
+		{"duplicate shape", `{"shapes": [{"name": "Rock"}, {"name": "Rock"}, {"name": "Scissors"}]}`, "duplicate shape"},
+		{"even cyclic game", `{"shapes": [{"name": "A"}, {"name": "B"}, {"name": "C"}, {"name": "D"}]}`, "odd number of shapes"},
+		{"unknown beaten shape", `{"shapes": [
+			{"name": "Rock", "beats": ["Scissors"]},
+			{"name": "Paper", "beats": ["Rock"]},
+			{"name": "Scissors", "beats": ["Lizard"]}]}`, "unknown shape"},
+		{"shape beats itself", `{"shapes": [
+			{"name": "Rock", "beats": ["Rock", "Scissors"]},
+			{"name": "Paper", "beats": ["Rock"]},
+			{"name": "Scissors", "beats": ["Paper"]}]}`, "can't beat itself"},
+		{"shapes beat each other", `{"shapes": [
+			{"name": "Rock", "beats": ["Scissors", "Paper"]},
+			{"name": "Paper", "beats": ["Rock"]},
+			{"name": "Scissors", "beats": ["Paper"]}]}`, "exactly one of Rock and Paper"},
+		{"neither shape wins", `{"shapes": [
+			{"name": "Rock", "beats": ["Scissors"]},
+			{"name": "Paper", "beats": ["Rock"]},
+			{"name": "Scissors"}]}`, "exactly one of Paper and Scissors"},
+		{"invalid JSON", `{"shapes": [`, "invalid game definition"},
+	}
+
+	for _, test := range tests {
+		_, err := LoadGame(strings.NewReader(test.definition))
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: err = %v, want an error containing %q", test.name, err, test.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package day2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// builtinInterpretations are the readings of the strategy guide's second
+// column from the two parts of the puzzle, in order.
+var builtinInterpretations = []struct {
+	name    string
+	mapping string
+}{
+	{"shape", "X=Rock,Y=Paper,Z=Scissors"},
+	{"outcome", "X=lose,Y=draw,Z=win"},
+}
+
+// Interpretation is a reading of the strategy guide's second column. Each
+// value of the column either names the shape to play or the outcome the
+// round should end in.
+type Interpretation struct {
+	Name     string
+	shapes   map[string]Shape
+	outcomes map[string]DesiredOutcome
+}
+
+// InterpretationNames returns the names of the built in interpretations, in
+// the order of the puzzle parts.
+func InterpretationNames() []string {
+	var names []string
+	for _, builtin := range builtinInterpretations {
+		names = append(names, builtin.name)
+	}
+
+	return names
+}
+
+// LookupInterpretation returns a built in interpretation by name.
+func LookupInterpretation(game *Game, name string) (*Interpretation, error) {
+	for _, builtin := range builtinInterpretations {
+		if builtin.name == name {
+			return ParseInterpretation(game, name, builtin.mapping)
+		}
+	}
+
+	return nil, fmt.Errorf("unknown interpretation %q, expected one of %s", name, strings.Join(InterpretationNames(), ", "))
+}
+
+// ParseInterpretation returns a custom interpretation from a mapping such as
+// "X=Rock,Y=draw,Z=Paper", where each column value maps to the name of one of
+// the game's shapes or to lose, draw or win.
+func ParseInterpretation(game *Game, name, mapping string) (*Interpretation, error) {
+	interpretation := &Interpretation{
+		Name:     name,
+		shapes:   map[string]Shape{},
+		outcomes: map[string]DesiredOutcome{},
+	}
+
+	for _, entry := range strings.Split(mapping, ",") {
+		parts := strings.SplitN(strings.TrimSpace(entry), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("%s: invalid mapping %q, expected COLUMN=SHAPE or COLUMN=OUTCOME", name, entry)
+		}
+
+		column, value := parts[0], parts[1]
+		if _, ok := interpretation.shapes[column]; ok {
+			return nil, fmt.Errorf("%s: column %s is mapped twice", name, column)
+		}
+		if _, ok := interpretation.outcomes[column]; ok {
+			return nil, fmt.Errorf("%s: column %s is mapped twice", name, column)
+		}
+
+		switch outcome := DesiredOutcome(value); outcome {
+		case DesiredOutcomeLose, DesiredOutcomeDraw, DesiredOutcomeWin:
+			interpretation.outcomes[column] = outcome
+			continue
+		}
+
+		shape, ok := game.Lookup(value)
+		if !ok {
+			return nil, fmt.Errorf("%s: %q is neither a shape nor an outcome", name, value)
+		}
+		interpretation.shapes[column] = shape
+	}
+
+	return interpretation, nil
+}
+
+// PlayerShape returns the shape to play against the opponent's shape for a
+// value of the second column.
+func (i *Interpretation) PlayerShape(game *Game, opponentShape Shape, column string) (Shape, error) {
+	if shape, ok := i.shapes[column]; ok {
+		return shape, nil
+	}
+
+	if outcome, ok := i.outcomes[column]; ok {
+		return game.Resolve(opponentShape, outcome)
+	}
+
+	return 0, fmt.Errorf("%s doesn't map column value %q", i.Name, column)
+}
+
+// Comparison is the result of playing a strategy guide under several
+// interpretations.
+type Comparison struct {
+	Interpretations []*Interpretation
+	Scores          []int        // player's total score under each interpretation
+	Differences     []*RoundPlay // rounds where the interpretations play different shapes
+}
+
+// RoundPlay is how a single round plays out under each interpretation.
+type RoundPlay struct {
+	Round         int // counting from 1
+	OpponentShape Shape
+	Column        string
+	PlayerShapes  []Shape
+	PlayerScores  []int
+}
+
+// CompareInterpretations plays the strategy guide under every interpretation.
+func CompareInterpretations(game *Game, rounds [][]string, interpretations []*Interpretation) (*Comparison, error) {
+	strategyGuide, err := getStrategyGuide(game)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &Comparison{
+		Interpretations: interpretations,
+		Scores:          make([]int, len(interpretations)),
+	}
+
+	for idx, columns := range rounds {
+		play := &RoundPlay{
+			Round:         idx + 1,
+			OpponentShape: strategyGuide[columns[0]],
+			Column:        columns[1],
+		}
+
+		differs := false
+		for i, interpretation := range interpretations {
+			playerShape, err := interpretation.PlayerShape(game, play.OpponentShape, play.Column)
+			if err != nil {
+				return nil, fmt.Errorf("round %d: %v", idx+1, err)
+			}
+
+			_, playerScore, _, err := game.RoundScores(play.OpponentShape, playerShape)
+			if err != nil {
+				return nil, fmt.Errorf("round %d: %v", idx+1, err)
+			}
+
+			comparison.Scores[i] += playerScore
+			play.PlayerShapes = append(play.PlayerShapes, playerShape)
+			play.PlayerScores = append(play.PlayerScores, playerScore)
+			differs = differs || playerShape != play.PlayerShapes[0]
+		}
+
+		if differs {
+			comparison.Differences = append(comparison.Differences, play)
+		}
+	}
+
+	return comparison, nil
+}
@@ -0,0 +1,95 @@
+package day2
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseInterpretation(t *testing.T) {
+	game := DefaultGame()
+
+	interpretation, err := ParseInterpretation(game, "mine", " X=Paper, Y=draw,Z=win")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		opponent Shape
+		column   string
+		want     Shape
+	}{
+		{ShapeRock, "X", ShapePaper},
+		{ShapeScissors, "X", ShapePaper},
+		{ShapeScissors, "Y", ShapeScissors},
+		{ShapeScissors, "Z", ShapeRock},
+	} {
+		got, err := interpretation.PlayerShape(game, test.opponent, test.column)
+		if err != nil || got != test.want {
+			t.Errorf("%s against %s = %s, %v, want %s", test.column, game.Name(test.opponent), game.Name(got), err, game.Name(test.want))
+		}
+	}
+
+	if _, err := interpretation.PlayerShape(game, ShapeRock, "W"); err == nil {
+		t.Error("expected an error for an unmapped column value")
+	}
+
+	for _, mapping := range []string{
+		"X=Rock,X=lose",  // mapped twice
+		"X=lose,X=Rock",  // mapped twice
+		"X=Banana",       // unknown value
+		"X=Rock,Y",       // no value
+		"=Rock",          // no column
+		"X=rock,Y=Paper", // shape names are case sensitive
+	} {
+		if _, err := ParseInterpretation(game, "bad", mapping); err == nil {
+			t.Errorf("expected an error for %q", mapping)
+		}
+	}
+}
+
+func TestLookupInterpretation(t *testing.T) {
+	if names := InterpretationNames(); !reflect.DeepEqual(names, []string{"shape", "outcome"}) {
+		t.Errorf("names = %v, want shape and outcome", names)
+	}
+
+	if _, err := LookupInterpretation(DefaultGame(), "mine"); err == nil {
+		t.Error("expected an error for an unknown interpretation")
+	}
+}
+
+func TestCompareInterpretations(t *testing.T) {
+	game := DefaultGame()
+
+	rounds, err := ReadStrategyGuide(strings.NewReader("A Y\nB X\nC Z\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var interpretations []*Interpretation
+	for _, name := range InterpretationNames() {
+		interpretation, err := LookupInterpretation(game, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		interpretations = append(interpretations, interpretation)
+	}
+
+	comparison, err := CompareInterpretations(game, rounds, interpretations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []int{15, 12}; !reflect.DeepEqual(comparison.Scores, want) {
+		t.Errorf("scores = %v, want %v", comparison.Scores, want)
+	}
+
+	// Both interpretations play Rock against Paper in the second round
+	want := []*RoundPlay{
+		{Round: 1, OpponentShape: ShapeRock, Column: "Y", PlayerShapes: []Shape{ShapePaper, ShapeRock}, PlayerScores: []int{8, 4}},
+		{Round: 3, OpponentShape: ShapeScissors, Column: "Z", PlayerShapes: []Shape{ShapeScissors, ShapeRock}, PlayerScores: []int{6, 7}},
+	}
+	if !reflect.DeepEqual(comparison.Differences, want) {
+		t.Errorf("differences = %+v, want %+v", comparison.Differences, want)
+	}
+}
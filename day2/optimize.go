@@ -0,0 +1,144 @@
+package day2
+
+import (
+	"fmt"
+	"math"
+)
+
+// Constraints limit the responses the optimiser may choose. A negative limit
+// means there is no limit.
+type Constraints struct {
+	MaxWins              int // most rounds the player may win
+	MaxConsecutiveLosses int // longest run of rounds the player may lose
+}
+
+// NoConstraints lets the optimiser choose any response.
+var NoConstraints = Constraints{MaxWins: -1, MaxConsecutiveLosses: -1}
+
+// Plan is a response to every round of a strategy guide.
+type Plan struct {
+	Score     int
+	Responses []Shape
+}
+
+// OpponentShapes returns the opponent's shape in every round of a strategy
+// guide, or an error if the game lacks Rock, Paper or Scissors.
+func OpponentShapes(game *Game, rounds [][]string) ([]Shape, error) {
+	strategyGuide, err := getStrategyGuide(game)
+	if err != nil {
+		return nil, err
+	}
+
+	shapes := make([]Shape, len(rounds))
+	for idx, columns := range rounds {
+		shapes[idx] = strategyGuide[columns[0]]
+	}
+
+	return shapes, nil
+}
+
+// Optimize returns the responses to the opponent's shapes that give the
+// player the highest score, or the lowest if maximize is false, within the
+// constraints.
+//
+// It is solved with dynamic programming over the rounds, where the state
+// after each round is the number of rounds won so far and the length of the
+// current losing streak. Each is only tracked if it is constrained.
+func Optimize(game *Game, opponentShapes []Shape, constraints Constraints, maximize bool) (*Plan, error) {
+	winStates, lossStates := 1, 1
+	if constraints.MaxWins >= 0 {
+		winStates = constraints.MaxWins + 1
+	}
+	if constraints.MaxConsecutiveLosses >= 0 {
+		lossStates = constraints.MaxConsecutiveLosses + 1
+	}
+	states := winStates * lossStates
+
+	unreachable := math.MinInt64
+	if !maximize {
+		unreachable = math.MaxInt64
+	}
+	better := func(a, b int) bool {
+		if maximize {
+			return a > b
+		}
+		return a < b
+	}
+
+	scores := make([]int, states)
+	for idx := range scores {
+		scores[idx] = unreachable
+	}
+	scores[0] = 0
+
+	// parents[round][state] is the state before the round and the shape
+	// played in it, for walking back from the final state
+	type step struct {
+		previous int
+		shape    Shape
+	}
+	parents := make([][]step, len(opponentShapes))
+
+	for round, opponentShape := range opponentShapes {
+		next := make([]int, states)
+		for idx := range next {
+			next[idx] = unreachable
+		}
+		parents[round] = make([]step, states)
+
+		for state, score := range scores {
+			if score == unreachable {
+				continue
+			}
+			wins, losses := state/lossStates, state%lossStates
+
+			for _, playerShape := range game.Shapes() {
+				_, playerScore, outcome, err := game.RoundScores(opponentShape, playerShape)
+				if err != nil {
+					return nil, fmt.Errorf("round %d: %v", round+1, err)
+				}
+
+				nextWins, nextLosses := wins, 0
+				switch outcome {
+				case OutcomePlayer:
+					if constraints.MaxWins >= 0 {
+						nextWins++
+					}
+				case OutcomeOpponent:
+					if constraints.MaxConsecutiveLosses >= 0 {
+						nextLosses = losses + 1
+					}
+				}
+				if nextWins >= winStates || nextLosses >= lossStates {
+					continue
+				}
+
+				nextState := nextWins*lossStates + nextLosses
+				if next[nextState] == unreachable || better(score+playerScore, next[nextState]) {
+					next[nextState] = score + playerScore
+					parents[round][nextState] = step{previous: state, shape: playerShape}
+				}
+			}
+		}
+
+		scores = next
+	}
+
+	// Drawing every round meets any constraints, so the state with no wins
+	// and no losing streak is always reachable
+	final := 0
+	for state, score := range scores {
+		if score != unreachable && better(score, scores[final]) {
+			final = state
+		}
+	}
+
+	plan := &Plan{Score: scores[final], Responses: make([]Shape, len(opponentShapes))}
+	state := final
+	for round := len(opponentShapes) - 1; round >= 0; round-- {
+		plan.Responses[round] = parents[round][state].shape
+		state = parents[round][state].previous
+	}
+
+	return plan, nil
+}
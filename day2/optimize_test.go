@@ -0,0 +1,123 @@
+package day2
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForce tries every response to every round, returning the best and
+// worst scores within the constraints.
+func bruteForce(t *testing.T, game *Game, opponentShapes []Shape, constraints Constraints) (best, worst int) {
+	responses := make([]Shape, len(opponentShapes))
+	found := false
+
+	var try func(round int)
+	try = func(round int) {
+		if round < len(responses) {
+			for _, shape := range game.Shapes() {
+				responses[round] = shape
+				try(round + 1)
+			}
+			return
+		}
+
+		score, satisfied := checkPlan(t, game, opponentShapes, constraints, responses)
+		if !satisfied {
+			return
+		}
+
+		if !found || score > best {
+			best = score
+		}
+		if !found || score < worst {
+			worst = score
+		}
+		found = true
+	}
+	try(0)
+
+	if !found {
+		t.Fatalf("no responses to %v satisfy %+v", opponentShapes, constraints)
+	}
+
+	return best, worst
+}
+
+// checkPlan returns the player's score for the responses and whether they
+// meet the constraints.
+func checkPlan(t *testing.T, game *Game, opponentShapes []Shape, constraints Constraints, responses []Shape) (int, bool) {
+	score, wins, losses, longestLosses := 0, 0, 0, 0
+	for idx, opponentShape := range opponentShapes {
+		_, playerScore, outcome, err := game.RoundScores(opponentShape, responses[idx])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		score += playerScore
+		switch outcome {
+		case OutcomePlayer:
+			wins++
+			losses = 0
+		case OutcomeOpponent:
+			losses++
+		default:
+			losses = 0
+		}
+		if losses > longestLosses {
+			longestLosses = losses
+		}
+	}
+
+	satisfied := (constraints.MaxWins < 0 || wins <= constraints.MaxWins) &&
+		(constraints.MaxConsecutiveLosses < 0 || longestLosses <= constraints.MaxConsecutiveLosses)
+
+	return score, satisfied
+}
+
+func TestOptimize(t *testing.T) {
+	game := DefaultGame()
+	rng := rand.New(rand.NewSource(15))
+
+	constraints := []Constraints{
+		NoConstraints,
+		{MaxWins: 0, MaxConsecutiveLosses: -1},
+		{MaxWins: -1, MaxConsecutiveLosses: 0},
+		{MaxWins: 2, MaxConsecutiveLosses: 1},
+		{MaxWins: 0, MaxConsecutiveLosses: 0},
+		{MaxWins: 4, MaxConsecutiveLosses: -1},
+	}
+
+	for trial := 0; trial < 30; trial++ {
+		opponentShapes := make([]Shape, rng.Intn(7)+1)
+		for idx := range opponentShapes {
+			opponentShapes[idx] = Shape(rng.Intn(3) + 1)
+		}
+
+		for _, constraint := range constraints {
+			best, worst := bruteForce(t, game, opponentShapes, constraint)
+
+			for _, maximize := range []bool{true, false} {
+				want := worst
+				if maximize {
+					want = best
+				}
+
+				plan, err := Optimize(game, opponentShapes, constraint, maximize)
+				if err != nil {
+					t.Fatalf("%v %+v: %v", opponentShapes, constraint, err)
+				}
+
+				if plan.Score != want {
+					t.Errorf("%v %+v maximize %t: score = %d, want %d", opponentShapes, constraint, maximize, plan.Score, want)
+				}
+
+				// The responses must get the score within the constraints
+				score, satisfied := checkPlan(t, game, opponentShapes, constraint, plan.Responses)
+				if score != plan.Score || !satisfied {
+					t.Errorf("%v %+v maximize %t: responses %v score %d and satisfy the constraints %t, want %d and true",
+						opponentShapes, constraint, maximize, plan.Responses, score, satisfied, plan.Score)
+				}
+			}
+		}
+	}
+}
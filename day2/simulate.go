@@ -0,0 +1,301 @@
+package day2
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+)
+
+// Move is one round of a match from the point of view of one of its players.
+type Move struct {
+	Own      Shape
+	Opponent Shape
+	Outcome  Outcome // OutcomePlayer if this player won the round
+}
+
+// Strategy picks the shape to play in each round of a match, knowing the
+// moves of the rounds played so far.
+type Strategy interface {
+	Name() string
+	Play(game *Game, history []Move, rng *rand.Rand) Shape
+}
+
+// strategyNames are the strategies NewStrategy knows, in the order they are
+// listed.
+var strategyNames = []string{"guide", "random", "frequency", "mirror", "win-stay-lose-shift"}
+
+// StrategyNames returns the names of the strategies NewStrategy knows.
+func StrategyNames() []string {
+	return append([]string(nil), strategyNames...)
+}
+
+// NewStrategy returns a strategy by name. The guide strategy plays the shapes
+// in guide over and over, so guide must not be empty for it.
+func NewStrategy(name string, guide []Shape) (Strategy, error) {
+	switch name {
+	case "guide":
+		if len(guide) == 0 {
+			return nil, fmt.Errorf("the guide strategy needs a strategy guide")
+		}
+		return &guideStrategy{shapes: guide}, nil
+	case "random":
+		return randomStrategy{}, nil
+	case "frequency":
+		return frequencyStrategy{}, nil
+	case "mirror":
+		return mirrorStrategy{}, nil
+	case "win-stay-lose-shift":
+		return winStayLoseShiftStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown strategy %q, expected one of %s", name, strings.Join(strategyNames, ", "))
+	}
+}
+
+func randomShape(game *Game, rng *rand.Rand) Shape {
+	return Shape(rng.Intn(len(game.Shapes())) + 1)
+}
+
+// guideStrategy plays a fixed sequence of shapes.
+type guideStrategy struct {
+	shapes []Shape
+}
+
+func (s *guideStrategy) Name() string { return "guide" }
+
+func (s *guideStrategy) Play(game *Game, history []Move, rng *rand.Rand) Shape {
+	return s.shapes[len(history)%len(s.shapes)]
+}
+
+// randomStrategy plays any shape with equal probability.
+type randomStrategy struct{}
+
+func (randomStrategy) Name() string { return "random" }
+
+func (randomStrategy) Play(game *Game, history []Move, rng *rand.Rand) Shape {
+	return randomShape(game, rng)
+}
+
+// frequencyStrategy plays to beat the shape the opponent has played most
+// often, picking at random between equally frequent shapes.
+type frequencyStrategy struct{}
+
+func (frequencyStrategy) Name() string { return "frequency" }
+
+func (frequencyStrategy) Play(game *Game, history []Move, rng *rand.Rand) Shape {
+	if len(history) == 0 {
+		return randomShape(game, rng)
+	}
+
+	counts := map[Shape]int{}
+	for _, move := range history {
+		counts[move.Opponent]++
+	}
+
+	var mostFrequent []Shape
+	for _, shape := range game.Shapes() {
+		switch {
+		case len(mostFrequent) == 0 || counts[shape] > counts[mostFrequent[0]]:
+			mostFrequent = []Shape{shape}
+		case counts[shape] == counts[mostFrequent[0]]:
+			mostFrequent = append(mostFrequent, shape)
+		}
+	}
+
+	shape, err := game.Resolve(mostFrequent[rng.Intn(len(mostFrequent))], DesiredOutcomeWin)
+	if err != nil {
+		return randomShape(game, rng)
+	}
+
+	return shape
+}
+
+// mirrorStrategy plays whatever the opponent played last.
+type mirrorStrategy struct{}
+
+func (mirrorStrategy) Name() string { return "mirror" }
+
+func (mirrorStrategy) Play(game *Game, history []Move, rng *rand.Rand) Shape {
+	if len(history) == 0 {
+		return randomShape(game, rng)
+	}
+
+	return history[len(history)-1].Opponent
+}
+
+// winStayLoseShiftStrategy keeps playing a shape while it wins, and moves on
+// to the next shape after a loss or a draw.
+type winStayLoseShiftStrategy struct{}
+
+func (winStayLoseShiftStrategy) Name() string { return "win-stay-lose-shift" }
+
+func (winStayLoseShiftStrategy) Play(game *Game, history []Move, rng *rand.Rand) Shape {
+	if len(history) == 0 {
+		return randomShape(game, rng)
+	}
+
+	last := history[len(history)-1]
+	if last.Outcome == OutcomePlayer {
+		return last.Own
+	}
+
+	return last.Own%Shape(len(game.Shapes())) + 1
+}
+
+// Simulation configures a tournament in which every strategy plays every
+// other strategy.
+type Simulation struct {
+	Game       *Game
+	Strategies []Strategy
+	Matches    int   // matches played by each pair of strategies
+	Rounds     int   // rounds in each match
+	Seed       int64 // seed of the random number generator, so runs can be repeated
+}
+
+// SimulationMetrics summarises the matches a strategy played against an
+// opponent, or against every opponent when Opponent is empty. Confidence
+// intervals are at 95%.
+type SimulationMetrics struct {
+	Strategy          string
+	Opponent          string
+	Matches           int
+	Wins              int
+	Losses            int
+	Draws             int
+	WinRate           float64
+	WinRateCI         [2]float64
+	MeanScore         float64 // mean score per match
+	MeanScoreCI       [2]float64
+	MeanOpponentScore float64
+
+	scores []int
+}
+
+// z is the standard normal quantile for 95% confidence intervals.
+const z = 1.959964
+
+// Run plays the tournament and returns the metrics of every strategy against
+// each opponent, followed by the metrics of every strategy against all of
+// them.
+func (s *Simulation) Run() ([]*SimulationMetrics, error) {
+	if len(s.Strategies) < 2 {
+		return nil, fmt.Errorf("a tournament needs at least 2 strategies")
+	}
+	if s.Matches < 1 || s.Rounds < 1 {
+		return nil, fmt.Errorf("matches and rounds must be at least 1")
+	}
+
+	rng := rand.New(rand.NewSource(s.Seed))
+
+	var matchups []*SimulationMetrics
+	overall := make([]*SimulationMetrics, len(s.Strategies))
+	for idx, strategy := range s.Strategies {
+		overall[idx] = &SimulationMetrics{Strategy: strategy.Name()}
+	}
+
+	for a := range s.Strategies {
+		for b := a + 1; b < len(s.Strategies); b++ {
+			forward := &SimulationMetrics{Strategy: s.Strategies[a].Name(), Opponent: s.Strategies[b].Name()}
+			backward := &SimulationMetrics{Strategy: s.Strategies[b].Name(), Opponent: s.Strategies[a].Name()}
+
+			for match := 0; match < s.Matches; match++ {
+				gameMetrics, err := s.playMatch(s.Strategies[a], s.Strategies[b], rng)
+				if err != nil {
+					return nil, fmt.Errorf("%s against %s: %v", forward.Strategy, forward.Opponent, err)
+				}
+
+				for _, metrics := range []*SimulationMetrics{forward, overall[a]} {
+					metrics.add(gameMetrics.playerScore, gameMetrics.opponentScore)
+				}
+				for _, metrics := range []*SimulationMetrics{backward, overall[b]} {
+					metrics.add(gameMetrics.opponentScore, gameMetrics.playerScore)
+				}
+			}
+
+			matchups = append(matchups, forward, backward)
+		}
+	}
+
+	results := append(matchups, overall...)
+	for _, metrics := range results {
+		metrics.summarise()
+	}
+
+	return results, nil
+}
+
+// playMatch plays one match, with the first strategy as the player.
+func (s *Simulation) playMatch(player, opponent Strategy, rng *rand.Rand) (*GameMetrics, error) {
+	gameMetrics := &GameMetrics{}
+	var playerHistory, opponentHistory []Move
+
+	for round := 0; round < s.Rounds; round++ {
+		playerShape := player.Play(s.Game, playerHistory, rng)
+		opponentShape := opponent.Play(s.Game, opponentHistory, rng)
+
+		result, err := gameMetrics.play(s.Game, opponentShape, playerShape)
+		if err != nil {
+			return nil, fmt.Errorf("round %d: %v", round+1, err)
+		}
+		outcome := result.Outcome
+
+		opponentOutcome := outcome
+		switch outcome {
+		case OutcomePlayer:
+			opponentOutcome = OutcomeOpponent
+		case OutcomeOpponent:
+			opponentOutcome = OutcomePlayer
+		}
+
+		playerHistory = append(playerHistory, Move{Own: playerShape, Opponent: opponentShape, Outcome: outcome})
+		opponentHistory = append(opponentHistory, Move{Own: opponentShape, Opponent: playerShape, Outcome: opponentOutcome})
+	}
+
+	return gameMetrics, nil
+}
+
+// add records the result of a match, won by whoever scored the most.
+func (m *SimulationMetrics) add(score, opponentScore int) {
+	m.Matches++
+	switch {
+	case score > opponentScore:
+		m.Wins++
+	case score < opponentScore:
+		m.Losses++
+	default:
+		m.Draws++
+	}
+
+	m.scores = append(m.scores, score)
+	m.MeanOpponentScore += (float64(opponentScore) - m.MeanOpponentScore) / float64(m.Matches)
+}
+
+// summarise computes the rates and confidence intervals once every match has
+// been recorded.
+func (m *SimulationMetrics) summarise() {
+	n := float64(m.Matches)
+
+	// Wilson score interval, which stays within [0, 1] for rates near 0 or 1
+	p := float64(m.Wins) / n
+	center := (p + z*z/(2*n)) / (1 + z*z/n)
+	margin := z / (1 + z*z/n) * math.Sqrt(p*(1-p)/n+z*z/(4*n*n))
+	m.WinRate = p
+	m.WinRateCI = [2]float64{center - margin, center + margin}
+
+	var sum float64
+	for _, score := range m.scores {
+		sum += float64(score)
+	}
+	m.MeanScore = sum / n
+
+	var squares float64
+	for _, score := range m.scores {
+		squares += (float64(score) - m.MeanScore) * (float64(score) - m.MeanScore)
+	}
+
+	margin = 0
+	if m.Matches > 1 {
+		margin = z * math.Sqrt(squares/(n-1)) / math.Sqrt(n)
+	}
+	m.MeanScoreCI = [2]float64{m.MeanScore - margin, m.MeanScore + margin}
+}
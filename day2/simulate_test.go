@@ -0,0 +1,160 @@
+package day2
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func newStrategies(t *testing.T, names ...string) []Strategy {
+	t.Helper()
+
+	var strategies []Strategy
+	for _, name := range names {
+		strategy, err := NewStrategy(name, []Shape{ShapePaper, ShapeRock})
+		if err != nil {
+			t.Fatal(err)
+		}
+		strategies = append(strategies, strategy)
+	}
+
+	return strategies
+}
+
+func TestSimulationSeed(t *testing.T) {
+	run := func(seed int64) []*SimulationMetrics {
+		simulation := &Simulation{
+			Game:       DefaultGame(),
+			Strategies: newStrategies(t, StrategyNames()...),
+			Matches:    20,
+			Rounds:     10,
+			Seed:       seed,
+		}
+
+		results, err := simulation.Run()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return results
+	}
+
+	first, second := run(42), run(42)
+	if !reflect.DeepEqual(first, second) {
+		t.Error("two runs with the same seed have different results")
+	}
+	if reflect.DeepEqual(first, run(43)) {
+		t.Error("runs with different seeds have the same results")
+	}
+
+	// Every pair plays in both directions, followed by a row per strategy
+	strategies := len(StrategyNames())
+	if want := strategies*(strategies-1) + strategies; len(first) != want {
+		t.Errorf("%d results, want %d", len(first), want)
+	}
+}
+
+func TestSimulationMetrics(t *testing.T) {
+	metrics := &SimulationMetrics{}
+	for match := 0; match < 10; match++ {
+		if match < 7 {
+			metrics.add(2, 1)
+		} else {
+			metrics.add(1, 2)
+		}
+	}
+	metrics.summarise()
+
+	// The Wilson score interval for 7 wins out of 10
+	if metrics.WinRate != 0.7 || math.Abs(metrics.WinRateCI[0]-0.3968) > 1e-4 || math.Abs(metrics.WinRateCI[1]-0.8922) > 1e-4 {
+		t.Errorf("win rate = %g (%v), want 0.7 (0.3968-0.8922)", metrics.WinRate, metrics.WinRateCI)
+	}
+
+	for _, test := range []struct {
+		wins int
+		want [2]float64
+	}{
+		{0, [2]float64{0, 0.2775}},
+		{10, [2]float64{0.7225, 1}},
+	} {
+		metrics := &SimulationMetrics{}
+		for match := 0; match < 10; match++ {
+			if match < test.wins {
+				metrics.add(2, 1)
+			} else {
+				metrics.add(1, 1)
+			}
+		}
+		metrics.summarise()
+
+		if math.Abs(metrics.WinRateCI[0]-test.want[0]) > 1e-4 || math.Abs(metrics.WinRateCI[1]-test.want[1]) > 1e-4 {
+			t.Errorf("%d wins: interval %v, want %v", test.wins, metrics.WinRateCI, test.want)
+		}
+	}
+
+	// Scores of 1, 2 and 3 have a mean of 2 and a standard deviation of 1
+	metrics = &SimulationMetrics{}
+	for _, score := range []int{1, 2, 3} {
+		metrics.add(score, 2)
+	}
+	metrics.summarise()
+
+	margin := z / math.Sqrt(3)
+	if metrics.MeanScore != 2 || math.Abs(metrics.MeanScoreCI[0]-(2-margin)) > 1e-9 || math.Abs(metrics.MeanScoreCI[1]-(2+margin)) > 1e-9 {
+		t.Errorf("mean score = %g (%v), want 2 (±%g)", metrics.MeanScore, metrics.MeanScoreCI, margin)
+	}
+	if metrics.Wins != 1 || metrics.Losses != 1 || metrics.Draws != 1 || metrics.MeanOpponentScore != 2 {
+		t.Errorf("%d wins, %d losses, %d draws, mean opponent score %g, want 1, 1, 1 and 2",
+			metrics.Wins, metrics.Losses, metrics.Draws, metrics.MeanOpponentScore)
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	game := DefaultGame()
+
+	// Without a history every strategy but the guide plays a random shape
+	for _, strategy := range newStrategies(t, StrategyNames()...) {
+		want := ShapePaper
+		if strategy.Name() != "guide" {
+			want = randomShape(game, rand.New(rand.NewSource(7)))
+		}
+
+		if got := strategy.Play(game, nil, rand.New(rand.NewSource(7))); got != want {
+			t.Errorf("%s opens with %s, want %s", strategy.Name(), game.Name(got), game.Name(want))
+		}
+	}
+
+	history := []Move{
+		{Own: ShapeRock, Opponent: ShapeScissors, Outcome: OutcomeDraw},
+		{Own: ShapeRock, Opponent: ShapeScissors, Outcome: OutcomePlayer},
+		{Own: ShapePaper, Opponent: ShapeScissors, Outcome: OutcomeOpponent},
+	}
+
+	tests := []struct {
+		strategy string
+		history  []Move
+		want     Shape
+	}{
+		{"guide", history, ShapeRock},
+		{"frequency", history, ShapeRock},
+		{"mirror", history, ShapeScissors},
+		{"win-stay-lose-shift", history, ShapeScissors},
+		{"win-stay-lose-shift", history[:2], ShapeRock},
+		{"win-stay-lose-shift", []Move{{Own: ShapeScissors, Opponent: ShapeRock, Outcome: OutcomeOpponent}}, ShapeRock},
+	}
+
+	for _, test := range tests {
+		strategy := newStrategies(t, test.strategy)[0]
+		if got := strategy.Play(game, test.history, rand.New(rand.NewSource(1))); got != test.want {
+			t.Errorf("%s after %d moves plays %s, want %s", test.strategy, len(test.history), game.Name(got), game.Name(test.want))
+		}
+	}
+
+	if _, err := NewStrategy("guide", nil); err == nil {
+		t.Error("expected an error for the guide strategy without a guide")
+	}
+	if _, err := NewStrategy("copycat", nil); err == nil {
+		t.Error("expected an error for an unknown strategy")
+	}
+}
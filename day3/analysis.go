@@ -0,0 +1,59 @@
+package day3
+
+import "fmt"
+
+// Analysis sums the priorities of shared items at the compartment level and
+// at the group level.
+type Analysis struct {
+	Compartments int // compartments each rucksack is split into
+	MinShared    int // compartments an item must be in to count as shared
+	GroupSize    int // elves in each group
+
+	// CompartmentSum is the sum over every rucksack of the priorities of the
+	// items in at least MinShared of its compartments.
+	CompartmentSum int
+
+	// GroupSum is the sum over every group of the priorities of the items
+	// carried by every elf in the group.
+	GroupSum int
+}
+
+// Analyze splits every rucksack into compartments equally sized compartments
+// and groups the rucksacks into groups of groupSize elves. With 2
+// compartments shared by 2 and groups of 3 it gives the answers to the
+// puzzle, without requiring exactly one shared item.
+func Analyze(table *PriorityTable, rucksacks []string, compartments, minShared, groupSize int) (*Analysis, error) {
+	if compartments < 1 {
+		return nil, fmt.Errorf("invalid number of compartments: %d", compartments)
+	}
+	if minShared < 1 || minShared > compartments {
+		return nil, fmt.Errorf("items must be shared by between 1 and %d compartments, not %d", compartments, minShared)
+	}
+
+	analysis := &Analysis{Compartments: compartments, MinShared: minShared, GroupSize: groupSize}
+
+	for idx, rucksack := range rucksacks {
+		sharedItems, err := getSharedItemsInCompartments(table, rucksack, compartments, minShared)
+		if err != nil {
+			return nil, fmt.Errorf("rucksack %d: %v", idx+1, err)
+		}
+
+		analysis.CompartmentSum += table.Sum(sharedItems)
+	}
+
+	elfGroups, err := groupElves(rucksacks, groupSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, elfGroup := range elfGroups {
+		sharedItems, err := findSharedItemsInElfGroup(table, elfGroup)
+		if err != nil {
+			return nil, fmt.Errorf("group %d: %v", idx+1, err)
+		}
+
+		analysis.GroupSum += table.Sum(sharedItems)
+	}
+
+	return analysis, nil
+}
@@ -0,0 +1,56 @@
+package day3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func TestAnalyze(t *testing.T) {
+	table := DefaultPriorityTable()
+	rucksacks := []string{"aabcadae", "bbbbcccc", "abcdefgh"}
+
+	tests := []struct {
+		compartments, minShared int
+		compartmentSum          int
+	}{
+		{4, 4, 0},
+		{4, 3, 1},
+		{4, 2, 1 + 2 + 3},
+		{4, 1, 15 + 2 + 3 + 36},
+		{2, 2, 1 + 0 + 0},
+		{1, 1, 15 + 5 + 36},
+	}
+
+	for _, test := range tests {
+		analysis, err := Analyze(table, rucksacks, test.compartments, test.minShared, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if analysis.CompartmentSum != test.compartmentSum {
+			t.Errorf("%d of %d compartments: sum = %d, want %d", test.minShared, test.compartments, analysis.CompartmentSum, test.compartmentSum)
+		}
+		if analysis.GroupSum != 2+3 {
+			t.Errorf("%d of %d compartments: group sum = %d, want %d", test.minShared, test.compartments, analysis.GroupSum, 2+3)
+		}
+	}
+
+	if _, err := Analyze(table, rucksacks, 4, 5, 3); err == nil {
+		t.Error("expected an error for items shared by more compartments than there are")
+	}
+}
+
+func TestReadRucksacksCompartments(t *testing.T) {
+	if _, err := ReadRucksacks(strings.NewReader("abcdef\nabc\n"), DefaultPriorityTable(), 3); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := ReadRucksacks(strings.NewReader("abcdef\nabcd\n"), DefaultPriorityTable(), 3)
+	var parseError *input.ParseError
+	if !errors.As(err, &parseError) || parseError.Line != 2 {
+		t.Errorf("err = %v, want a parse error on line 2", err)
+	}
+}
@@ -0,0 +1,222 @@
+package day3
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"unicode/utf8"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+type RuckSack struct {
+	items         string
+	duplicateItem rune
+}
+
+func init() {
+	puzzle.Register(3, func() puzzle.Solver { return NewSolver(3, DefaultPriorityTable()) })
+}
+
+// NewSolver returns a solver that looks for the badge shared by each group of
+// groupSize elves, scoring items with the priority table.
+func NewSolver(groupSize int, table *PriorityTable) *Solver {
+	return &Solver{groupSize: groupSize, table: table}
+}
+
+// Solver finds the misplaced items in each rucksack.
+type Solver struct {
+	rucksacks []string
+	groupSize int // number of elves in each group sharing a badge
+	table     *PriorityTable
+}
+
+// getSharedItemsInCompartments splits a rucksack into k equally sized
+// compartments and returns every item found in at least m of them.
+func getSharedItemsInCompartments(table *PriorityTable, value string, k, m int) (ItemSet, error) {
+	size := utf8.RuneCountInString(value) / k
+
+	var sharedItems ItemSet
+	var counts [maxItemTypes]int
+	start := 0
+	for i := 0; i < k; i++ {
+		end := start
+		for n := 0; n < size; n++ {
+			_, width := utf8.DecodeRuneInString(value[end:])
+			end += width
+		}
+
+		compartment, err := table.ItemSet(value[start:end])
+		if err != nil {
+			return 0, err
+		}
+		start = end
+
+		// Items in every compartment are the common case, and need no counting
+		if m == k {
+			if i == 0 {
+				sharedItems = compartment
+			}
+			sharedItems = sharedItems.Intersect(compartment)
+			continue
+		}
+
+		for remaining := uint64(compartment); remaining != 0; remaining &= remaining - 1 {
+			idx := bits.TrailingZeros64(remaining)
+			counts[idx]++
+			if counts[idx] == m {
+				sharedItems |= 1 << idx
+			}
+		}
+	}
+
+	return sharedItems, nil
+}
+
+// findSharedItemsInElfGroup returns every item carried by all the elves in a
+// group.
+func findSharedItemsInElfGroup(table *PriorityTable, elfGroup []string) (ItemSet, error) {
+	sharedItems, err := table.ItemSet(elfGroup[0])
+	if err != nil {
+		return 0, err
+	}
+
+	for _, elf := range elfGroup[1:] {
+		items, err := table.ItemSet(elf)
+		if err != nil {
+			return 0, err
+		}
+
+		sharedItems = sharedItems.Intersect(items)
+	}
+
+	return sharedItems, nil
+}
+
+// singleSharedItem checks that sharedItems holds exactly one item, returning
+// an error if there are none or several.
+func singleSharedItem(table *PriorityTable, sharedItems ItemSet) error {
+	switch sharedItems.Popcount() {
+	case 0:
+		return fmt.Errorf("no items are shared")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("%d items are shared instead of one: %q", sharedItems.Popcount(), string(table.Items(sharedItems)))
+	}
+}
+
+// groupElves splits the rucksacks into consecutive groups of groupSize elves.
+func groupElves(rucksacks []string, groupSize int) ([][]string, error) {
+	if groupSize < 1 {
+		return nil, fmt.Errorf("invalid group size: %d", groupSize)
+	}
+	if len(rucksacks)%groupSize != 0 {
+		return nil, fmt.Errorf("%d rucksacks can't be split into groups of %d", len(rucksacks), groupSize)
+	}
+
+	var elfGroups [][]string
+	for start := 0; start < len(rucksacks); start += groupSize {
+		elfGroups = append(elfGroups, rucksacks[start:start+groupSize])
+	}
+
+	return elfGroups, nil
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	rucksacks, err := ReadRucksacks(r, s.table, 2)
+	if err != nil {
+		return err
+	}
+
+	s.rucksacks = rucksacks
+
+	return nil
+}
+
+// ReadRucksacks returns the items in every rucksack, checking that every item
+// has a priority and that each rucksack splits evenly into the compartments.
+func ReadRucksacks(r io.Reader, table *PriorityTable, compartments int) ([]string, error) {
+	if compartments < 1 {
+		return nil, fmt.Errorf("invalid number of compartments: %d", compartments)
+	}
+
+	rucksacks, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, rucksack := range rucksacks {
+		for column, item := range []rune(rucksack) {
+			if _, ok := table.Priority(item); !ok {
+				return nil, &input.ParseError{
+					Day:      3,
+					Line:     idx + 1,
+					Column:   column + 1,
+					Text:     rucksack,
+					Expected: "an item type with a priority",
+					Err:      fmt.Errorf("%q isn't in the priority table", item),
+				}
+			}
+		}
+
+		if utf8.RuneCountInString(rucksack)%compartments != 0 {
+			expected := fmt.Sprintf("a multiple of %d items to split between the %d compartments", compartments, compartments)
+			if compartments == 2 {
+				expected = "an even number of items to split between the two compartments"
+			}
+
+			return nil, &input.ParseError{
+				Day:      3,
+				Line:     idx + 1,
+				Text:     rucksack,
+				Expected: expected,
+			}
+		}
+	}
+
+	return rucksacks, nil
+}
+
+// Part1 returns the sum of the priorities of the item found in both
+// compartments of each rucksack.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	sum := 0
+	for idx, rucksack := range s.rucksacks {
+		duplicateItems, err := getSharedItemsInCompartments(s.table, rucksack, 2, 2)
+		if err == nil {
+			err = singleSharedItem(s.table, duplicateItems)
+		}
+		if err != nil {
+			return puzzle.Answer{}, fmt.Errorf("rucksack %d: %v", idx+1, err)
+		}
+
+		sum += s.table.Sum(duplicateItems)
+	}
+
+	return puzzle.Int(sum), nil
+}
+
+// Part2 returns the sum of the badge priorities of every group of elves.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	elfGroups, err := groupElves(s.rucksacks, s.groupSize)
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	sum := 0
+	for idx, elfGroup := range elfGroups {
+		badges, err := findSharedItemsInElfGroup(s.table, elfGroup)
+		if err == nil {
+			err = singleSharedItem(s.table, badges)
+		}
+		if err != nil {
+			return puzzle.Answer{}, fmt.Errorf("group %d: %v", idx+1, err)
+		}
+
+		sum += s.table.Sum(badges)
+	}
+
+	return puzzle.Int(sum), nil
+}
@@ -0,0 +1,41 @@
+package day3
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSharedItemErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		groupSize int
+		part      int
+		want      string
+	}{
+		{"no item in both compartments", "abcd\n", 1, 1, "rucksack 1: no items are shared"},
+		{"several items in both compartments", "aa\nabab\n", 1, 1, `rucksack 2: 2 items are shared instead of one: "ab"`},
+		{"no badge", "aa\nbb\n", 2, 2, "group 1: no items are shared"},
+		{"several badges", "abab\nabab\ncc\ncc\n", 2, 2, `group 1: 2 items are shared instead of one: "ab"`},
+		{"rucksacks left over", "aa\naa\naa\n", 2, 2, "3 rucksacks can't be split into groups of 2"},
+		{"invalid group size", "aa\n", 0, 2, "invalid group size: 0"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			solver := NewSolver(test.groupSize, DefaultPriorityTable())
+			if err := solver.Parse(strings.NewReader(test.input)); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := solver.Part1()
+			if test.part == 2 {
+				_, err = solver.Part2()
+			}
+
+			if err == nil || err.Error() != test.want {
+				t.Errorf("err = %v, want %q", err, test.want)
+			}
+		})
+	}
+}
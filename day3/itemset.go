@@ -0,0 +1,28 @@
+package day3
+
+import "math/bits"
+
+// ItemSet is a set of item types with one bit per item type of a
+// PriorityTable, so set operations are single bitwise operations. With the
+// default table bit 0 is a and bit 51 is Z.
+type ItemSet uint64
+
+// Union returns the item types in either set.
+func (s ItemSet) Union(other ItemSet) ItemSet {
+	return s | other
+}
+
+// Intersect returns the item types in both sets.
+func (s ItemSet) Intersect(other ItemSet) ItemSet {
+	return s & other
+}
+
+// Difference returns the item types in s but not in other.
+func (s ItemSet) Difference(other ItemSet) ItemSet {
+	return s &^ other
+}
+
+// Popcount returns the number of item types in the set.
+func (s ItemSet) Popcount() int {
+	return bits.OnesCount64(uint64(s))
+}
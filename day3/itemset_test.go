@@ -0,0 +1,212 @@
+package day3
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+)
+
+const itemTypes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var (
+	syntheticOnce      sync.Once
+	syntheticRucksacks []string
+)
+
+// randomRucksacks returns n random rucksacks of 16 to 64 items.
+func randomRucksacks(n int) []string {
+	rng := rand.New(rand.NewSource(1))
+
+	rucksacks := make([]string, n)
+	for idx := range rucksacks {
+		items := make([]byte, 2*(8+rng.Intn(25)))
+		for i := range items {
+			items[i] = itemTypes[rng.Intn(len(itemTypes))]
+		}
+		rucksacks[idx] = string(items)
+	}
+
+	return rucksacks
+}
+
+// synthetic returns the million rucksacks the benchmarks run on.
+func synthetic() []string {
+	syntheticOnce.Do(func() {
+		syntheticRucksacks = randomRucksacks(1000000)
+	})
+
+	return syntheticRucksacks
+}
+
+// mapSharedItemsInCompartments and mapSharedItemsInElfGroup are the map based
+// implementations the item sets replaced, kept to compare against.
+func mapSharedItemsInCompartments(value string) []rune {
+	half := len(value) / 2
+
+	existingItems := map[string]bool{}
+	for _, item := range value[:half] {
+		existingItems[string(item)] = true
+	}
+
+	sharedItems := map[rune]bool{}
+	for _, item := range value[half:] {
+		if existingItems[string(item)] {
+			sharedItems[item] = true
+		}
+	}
+
+	return sortedByPriority(sharedItems)
+}
+
+func mapSharedItemsInElfGroup(elfGroup []string) []rune {
+	lookup := map[rune]int{}
+	for _, item := range elfGroup[0] {
+		lookup[item] = 1
+	}
+
+	for _, elf := range elfGroup[1:] {
+		seenItemsInLookup := map[rune]bool{}
+		for _, item := range elf {
+			if seenItemsInLookup[item] {
+				continue
+			}
+
+			if _, ok := lookup[item]; ok {
+				lookup[item]++
+				seenItemsInLookup[item] = true
+			}
+		}
+	}
+
+	sharedItems := map[rune]bool{}
+	for item, count := range lookup {
+		if count == len(elfGroup) {
+			sharedItems[item] = true
+		}
+	}
+
+	return sortedByPriority(sharedItems)
+}
+
+func sortedByPriority(items map[rune]bool) []rune {
+	var sorted []rune
+	for item := range items {
+		sorted = append(sorted, item)
+	}
+
+	table := DefaultPriorityTable()
+	sort.Slice(sorted, func(i, j int) bool {
+		return table.index[sorted[i]] < table.index[sorted[j]]
+	})
+
+	return sorted
+}
+
+func TestItemSetMatchesMaps(t *testing.T) {
+	table := DefaultPriorityTable()
+	rucksacks := randomRucksacks(9999)
+
+	for _, rucksack := range rucksacks {
+		want := string(mapSharedItemsInCompartments(rucksack))
+		got, err := getSharedItemsInCompartments(table, rucksack, 2, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(table.Items(got)) != want {
+			t.Fatalf("compartments of %s: got %q, want %q", rucksack, string(table.Items(got)), want)
+		}
+	}
+
+	elfGroups, err := groupElves(rucksacks, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, elfGroup := range elfGroups {
+		want := string(mapSharedItemsInElfGroup(elfGroup))
+		got, err := findSharedItemsInElfGroup(table, elfGroup)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(table.Items(got)) != want {
+			t.Fatalf("group %v: got %q, want %q", elfGroup, string(table.Items(got)), want)
+		}
+	}
+}
+
+func TestItemSetOperations(t *testing.T) {
+	table := DefaultPriorityTable()
+	a, err := table.ItemSet("abcXY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := table.ItemSet("cdYZ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		set  ItemSet
+		want string
+	}{
+		{"union", a.Union(b), "abcdXYZ"},
+		{"intersect", a.Intersect(b), "cY"},
+		{"difference", a.Difference(b), "abX"},
+	}
+
+	for _, test := range tests {
+		if got := string(table.Items(test.set)); got != test.want {
+			t.Errorf("%s = %q, want %q", test.name, got, test.want)
+		}
+		if test.set.Popcount() != len(test.want) {
+			t.Errorf("%s has popcount %d, want %d", test.name, test.set.Popcount(), len(test.want))
+		}
+	}
+}
+
+func BenchmarkCompartments(b *testing.B) {
+	rucksacks := synthetic()
+
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, rucksack := range rucksacks {
+				mapSharedItemsInCompartments(rucksack)
+			}
+		}
+	})
+
+	table := DefaultPriorityTable()
+	b.Run("itemset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, rucksack := range rucksacks {
+				getSharedItemsInCompartments(table, rucksack, 2, 2)
+			}
+		}
+	})
+}
+
+func BenchmarkElfGroups(b *testing.B) {
+	elfGroups, err := groupElves(synthetic()[:999999], 3)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("map", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, elfGroup := range elfGroups {
+				mapSharedItemsInElfGroup(elfGroup)
+			}
+		}
+	})
+
+	table := DefaultPriorityTable()
+	b.Run("itemset", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, elfGroup := range elfGroups {
+				findSharedItemsInElfGroup(table, elfGroup)
+			}
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package day3
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/darthchudi/aoc2022/input"
+)
+
+// maxItemTypes is the most item types a table can have, so that every item
+// set fits in an ItemSet.
+const maxItemTypes = 64
+
+// defaultPriorityTable gives a to z priorities 1 to 26 and A to Z priorities
+// 27 to 52, as in the puzzle.
+const defaultPriorityTable = `abcdefghijklmnopqrstuvwxyz 1
+ABCDEFGHIJKLMNOPQRSTUVWXYZ 27`
+
+// PriorityTable maps item types to their priorities. Item sets built from a
+// table have one bit per item type, in the order the table defines them.
+type PriorityTable struct {
+	items      []rune
+	priorities []int
+	index      map[rune]int
+	ascii      [utf8.RuneSelf]int8 // index of each ASCII item type plus one, for fast lookups
+}
+
+// DefaultPriorityTable returns the priorities from the puzzle.
+func DefaultPriorityTable() *PriorityTable {
+	table, err := LoadPriorityTable(strings.NewReader(defaultPriorityTable))
+	if err != nil {
+		panic(err)
+	}
+
+	return table
+}
+
+// LoadPriorityTable reads a priority table. Each line holds a run of item
+// types and the priority of the first of them, and the rest get consecutive
+// priorities:
+//
+//	abcdefghijklmnopqrstuvwxyz 1
+//	0123456789 53
+//	αβγ 100
+//
+// Blank lines and lines starting with # are ignored. A table can have at most
+// 64 item types, as every set of items is kept in a single 64-bit ItemSet, so
+// the puzzle's 52 letters leave room for 12 more.
+func LoadPriorityTable(r io.Reader) (*PriorityTable, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &PriorityTable{index: map[rune]int{}}
+	for idx, line := range lines {
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, &input.ParseError{
+				Line:     idx + 1,
+				Text:     line,
+				Expected: "item types and the priority of the first, like \"abc 1\"",
+			}
+		}
+
+		// Columns count runes, so item types like α take a single column
+		itemsColumn := utf8.RuneCountInString(line[:strings.Index(line, fields[0])]) + 1
+		priorityColumn := utf8.RuneCountInString(line[:strings.LastIndex(line, fields[1])]) + 1
+
+		firstPriority, err := strconv.Atoi(fields[1])
+		if err != nil || firstPriority < 1 {
+			return nil, &input.ParseError{
+				Line:     idx + 1,
+				Column:   priorityColumn,
+				Text:     line,
+				Expected: "a priority of at least 1",
+				Err:      err,
+			}
+		}
+
+		for offset, item := range []rune(fields[0]) {
+			if _, ok := table.index[item]; ok {
+				return nil, &input.ParseError{
+					Line:     idx + 1,
+					Column:   itemsColumn + offset,
+					Text:     line,
+					Expected: fmt.Sprintf("each item type once, but %q was already given a priority", item),
+				}
+			}
+			if len(table.items) == maxItemTypes {
+				return nil, &input.ParseError{
+					Line:     idx + 1,
+					Column:   itemsColumn + offset,
+					Text:     line,
+					Expected: fmt.Sprintf("at most %d item types in the table", maxItemTypes),
+				}
+			}
+
+			table.index[item] = len(table.items)
+			if item < utf8.RuneSelf {
+				table.ascii[item] = int8(len(table.items) + 1)
+			}
+			table.items = append(table.items, item)
+			table.priorities = append(table.priorities, firstPriority+offset)
+		}
+	}
+
+	if len(table.items) == 0 {
+		return nil, fmt.Errorf("the priority table has no item types")
+	}
+
+	return table, nil
+}
+
+// Priority returns the priority of an item type. The second result is false
+// if the table doesn't have the item type.
+func (t *PriorityTable) Priority(item rune) (int, bool) {
+	idx, ok := t.lookup(item)
+	if !ok {
+		return 0, false
+	}
+
+	return t.priorities[idx], true
+}
+
+// ItemSet returns the set of item types in items, or an error naming the
+// first rune the table has no priority for.
+func (t *PriorityTable) ItemSet(items string) (ItemSet, error) {
+	var set ItemSet
+	column := 0
+	for _, item := range items {
+		column++
+
+		idx, ok := t.lookup(item)
+		if !ok {
+			return 0, fmt.Errorf("item %q at column %d has no priority", item, column)
+		}
+
+		set |= 1 << idx
+	}
+
+	return set, nil
+}
+
+// lookup returns the position of an item type in the table.
+func (t *PriorityTable) lookup(item rune) (int, bool) {
+	if item >= 0 && item < utf8.RuneSelf {
+		idx := int(t.ascii[item]) - 1
+		return idx, idx >= 0
+	}
+
+	idx, ok := t.index[item]
+	return idx, ok
+}
+
+// Items returns the item types in a set, in the order the table defines them.
+func (t *PriorityTable) Items(set ItemSet) []rune {
+	var items []rune
+	for remaining := uint64(set); remaining != 0; remaining &= remaining - 1 {
+		items = append(items, t.items[bits.TrailingZeros64(remaining)])
+	}
+
+	return items
+}
+
+// Sum returns the sum of the priorities of the item types in a set.
+func (t *PriorityTable) Sum(set ItemSet) int {
+	sum := 0
+	for remaining := uint64(set); remaining != 0; remaining &= remaining - 1 {
+		sum += t.priorities[bits.TrailingZeros64(remaining)]
+	}
+
+	return sum
+}
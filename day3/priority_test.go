@@ -0,0 +1,83 @@
+package day3
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func TestDefaultPriorityTable(t *testing.T) {
+	table := DefaultPriorityTable()
+
+	for item := 'a'; item <= 'z'; item++ {
+		if priority, ok := table.Priority(item); !ok || priority != int(item-'a')+1 {
+			t.Errorf("priority of %q = %d, want %d", item, priority, int(item-'a')+1)
+		}
+	}
+	for item := 'A'; item <= 'Z'; item++ {
+		if priority, ok := table.Priority(item); !ok || priority != int(item-'A')+27 {
+			t.Errorf("priority of %q = %d, want %d", item, priority, int(item-'A')+27)
+		}
+	}
+
+	for _, item := range "0é " {
+		if _, ok := table.Priority(item); ok {
+			t.Errorf("%q has a priority", item)
+		}
+	}
+}
+
+func TestLoadPriorityTable(t *testing.T) {
+	table, err := LoadPriorityTable(strings.NewReader("# digits and greek\n0123456789 1\n\nαβγ 100\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := table.ItemSet("9γ9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := table.Sum(set); got != 10+102 {
+		t.Errorf("sum = %d, want %d", got, 10+102)
+	}
+
+	if _, err := table.ItemSet("0a"); err == nil {
+		t.Error("expected an error for an item missing from the table")
+	}
+
+	// 52 letters, 10 digits and 3 Greek letters are one item type too many
+	tooMany := defaultPriorityTable + "\n0123456789 53\nαβγ 63"
+	if _, err := LoadPriorityTable(strings.NewReader(tooMany)); err == nil || !strings.Contains(err.Error(), "at most 64 item types") {
+		t.Errorf("err = %v, want an error for more than 64 item types", err)
+	}
+
+	for _, test := range []struct {
+		definition string
+		column     int
+	}{
+		{"αβγ x", 5},
+		{"αβγα 1", 4},
+		{"αβ 1\n  γβ 3", 4},
+		{"ab 1\nb 3", 1},
+		{tooMany, 3},
+	} {
+		_, err := LoadPriorityTable(strings.NewReader(test.definition))
+
+		var parseError *input.ParseError
+		if !errors.As(err, &parseError) {
+			t.Errorf("LoadPriorityTable(%q) = %v, want a parse error", test.definition, err)
+			continue
+		}
+		if parseError.Column != test.column {
+			t.Errorf("LoadPriorityTable(%q) error in column %d, want column %d", test.definition, parseError.Column, test.column)
+		}
+	}
+
+	for _, definition := range []string{"ab", "ab 0", "ab 1\nb 3", "", "ab x"} {
+		if _, err := LoadPriorityTable(strings.NewReader(definition)); err == nil {
+			t.Errorf("expected an error loading %q", definition)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package day4
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/interval"
+)
+
+// Elf identifies an elf by where its assignment is in the input.
+type Elf struct {
+	Line     int `json:"line"`     // counting from 1
+	Position int `json:"position"` // position of the assignment on its line, counting from 1
+}
+
+func (e Elf) String() string {
+	return fmt.Sprintf("%d:%d", e.Line, e.Position)
+}
+
+// Assignment is the sections assigned to an elf.
+type Assignment struct {
+	Elf      Elf               `json:"elf"`
+	Sections interval.Interval `json:"sections"`
+}
+
+// ElfPair is two elves, on any lines, whose assignments overlap.
+type ElfPair struct {
+	First   Assignment        `json:"first"`
+	Second  Assignment        `json:"second"`
+	Overlap interval.Interval `json:"overlap"`
+}
+
+// CampReport describes the assignments of every elf in the camp together.
+type CampReport struct {
+	Elves int `json:"elves"`
+
+	// MaxCoverage is the most elves assigned to any one section, and
+	// MaxCoverageSections are the sections with that many elves.
+	MaxCoverage         int                 `json:"maxCoverage"`
+	MaxCoverageSections []interval.Interval `json:"maxCoverageSections"`
+
+	Covered       []interval.Interval `json:"covered"`
+	CoveredLength int                 `json:"coveredLength"`
+
+	// Uncovered are the sections nobody is assigned to, between the lowest
+	// and highest assigned sections.
+	Uncovered []interval.Interval `json:"uncovered"`
+
+	// OverlappingPairCount is always filled in, even when the pairs
+	// themselves are left out.
+	OverlappingPairCount int        `json:"overlappingPairCount"`
+	OverlappingPairs     []*ElfPair `json:"overlappingPairs,omitempty"`
+
+	// Groups compares the elves on each line, if they were analysed
+	Groups []*GroupReport `json:"groups,omitempty"`
+}
+
+// Assignments flattens the assignments read by ReadAssignments.
+func Assignments(lines [][]interval.Interval) []Assignment {
+	var assignments []Assignment
+	for line, sections := range lines {
+		for position, section := range sections {
+			assignments = append(assignments, Assignment{
+				Elf:      Elf{Line: line + 1, Position: position + 1},
+				Sections: section,
+			})
+		}
+	}
+
+	return assignments
+}
+
+// AnalyzeCamp reports on every assignment together. Both coverage and the
+// overlapping pairs are found by sweeping over the assignments in order of
+// their first section, so it takes O(n log n + k) time for n assignments and
+// k overlapping pairs.
+func AnalyzeCamp(assignments []Assignment) *CampReport {
+	report := &CampReport{Elves: len(assignments)}
+
+	sections := make([]interval.Interval, len(assignments))
+	for idx, assignment := range assignments {
+		sections[idx] = assignment.Sections
+	}
+
+	report.Covered = interval.Merge(sections)
+	for idx, covered := range report.Covered {
+		report.CoveredLength += covered.Length()
+		if idx > 0 {
+			gap := interval.Interval{Start: report.Covered[idx-1].End + 1, End: covered.Start - 1}
+			report.Uncovered = append(report.Uncovered, gap)
+		}
+	}
+
+	report.MaxCoverage, report.MaxCoverageSections = maxCoverage(sections)
+	report.OverlappingPairs = overlappingPairs(assignments)
+	report.OverlappingPairCount = len(report.OverlappingPairs)
+
+	return report
+}
+
+// maxCoverage returns the most intervals covering any one integer, and where
+// that many intervals overlap.
+func maxCoverage(sections []interval.Interval) (int, []interval.Interval) {
+	// Each interval adds one from its start and removes one after its end
+	type event struct {
+		position int
+		delta    int
+	}
+
+	events := make([]event, 0, 2*len(sections))
+	for _, section := range sections {
+		events = append(events, event{section.Start, 1}, event{section.End + 1, -1})
+	}
+	sort.Slice(events, func(a, b int) bool {
+		if events[a].position != events[b].position {
+			return events[a].position < events[b].position
+		}
+		return events[a].delta < events[b].delta
+	})
+
+	most, coverage := 0, 0
+	var busiest []interval.Interval
+	for idx, e := range events {
+		coverage += e.delta
+
+		// Wait until every event at this position has been applied
+		if idx+1 < len(events) && events[idx+1].position == e.position {
+			continue
+		}
+		if coverage == 0 || coverage < most {
+			continue
+		}
+
+		run := interval.Interval{Start: e.position, End: events[idx+1].position - 1}
+		if coverage > most {
+			most, busiest = coverage, nil
+		}
+		busiest = append(busiest, run)
+	}
+
+	return most, interval.Merge(busiest)
+}
+
+// activeHeap is a min-heap of the assignments overlapping the sweep line, so
+// the one ending first is the first to leave it.
+type activeHeap []Assignment
+
+func (h activeHeap) Len() int { return len(h) }
+
+func (h activeHeap) Less(i, j int) bool { return h[i].Sections.End < h[j].Sections.End }
+
+func (h activeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *activeHeap) Push(x interface{}) { *h = append(*h, x.(Assignment)) }
+
+func (h *activeHeap) Pop() interface{} {
+	old := *h
+	assignment := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return assignment
+}
+
+// overlappingPairs returns every pair of overlapping assignments. Sweeping
+// through the assignments by their first section, an assignment overlaps
+// exactly the earlier ones that haven't ended before it starts.
+func overlappingPairs(assignments []Assignment) []*ElfPair {
+	sorted := append([]Assignment(nil), assignments...)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return sorted[a].Sections.Start < sorted[b].Sections.Start
+	})
+
+	var pairs []*ElfPair
+	var active activeHeap
+	for _, assignment := range sorted {
+		for active.Len() > 0 && active[0].Sections.End < assignment.Sections.Start {
+			heap.Pop(&active)
+		}
+
+		for _, other := range active {
+			first, second := other, assignment
+			if elfLess(second.Elf, first.Elf) {
+				first, second = second, first
+			}
+
+			overlap, _ := first.Sections.Intersect(second.Sections)
+			pairs = append(pairs, &ElfPair{First: first, Second: second, Overlap: overlap})
+		}
+
+		heap.Push(&active, assignment)
+	}
+
+	sort.Slice(pairs, func(a, b int) bool {
+		if pairs[a].First.Elf != pairs[b].First.Elf {
+			return elfLess(pairs[a].First.Elf, pairs[b].First.Elf)
+		}
+		return elfLess(pairs[a].Second.Elf, pairs[b].Second.Elf)
+	})
+
+	return pairs
+}
+
+func elfLess(a, b Elf) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	return a.Position < b.Position
+}
+
+// WriteTable writes the report as aligned text, followed by the groups if
+// they were analysed. The overlapping pairs are only listed if listPairs is
+// true, as there can be a great many of them.
+func (r *CampReport) WriteTable(w io.Writer, listPairs bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "elves\t%d\n", r.Elves)
+	fmt.Fprintf(tw, "max elves per section\t%d\n", r.MaxCoverage)
+	fmt.Fprintf(tw, "busiest sections\t%v\n", r.MaxCoverageSections)
+	fmt.Fprintf(tw, "covered sections\t%v\n", r.Covered)
+	fmt.Fprintf(tw, "covered length\t%d\n", r.CoveredLength)
+	fmt.Fprintf(tw, "uncovered sections\t%v\n", r.Uncovered)
+	fmt.Fprintf(tw, "overlapping pairs\t%d\n", r.OverlappingPairCount)
+
+	if listPairs {
+		fmt.Fprintln(tw, "\nelf\tsections\telf\tsections\toverlap")
+		for _, pair := range r.OverlappingPairs {
+			fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\n", pair.First.Elf, pair.First.Sections, pair.Second.Elf, pair.Second.Sections, pair.Overlap)
+		}
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(r.Groups) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	return WriteGroups(w, r.Groups)
+}
@@ -0,0 +1,88 @@
+package day4
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/interval"
+)
+
+func TestAnalyzeCamp(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+
+	var lines [][]interval.Interval
+	for line := 0; line < 200; line++ {
+		var pair []interval.Interval
+		for position := 0; position < 2; position++ {
+			start := rng.Intn(90) + 1
+			pair = append(pair, interval.Interval{Start: start, End: start + rng.Intn(8)})
+		}
+		lines = append(lines, pair)
+	}
+	assignments := Assignments(lines)
+
+	report := AnalyzeCamp(assignments)
+
+	// Compare against counting the elves in every section, and checking every
+	// pair of elves
+	coverage := map[int]int{}
+	low, high := assignments[0].Sections.Start, assignments[0].Sections.End
+	for _, assignment := range assignments {
+		for section := assignment.Sections.Start; section <= assignment.Sections.End; section++ {
+			coverage[section]++
+		}
+		if assignment.Sections.Start < low {
+			low = assignment.Sections.Start
+		}
+		if assignment.Sections.End > high {
+			high = assignment.Sections.End
+		}
+	}
+
+	most := 0
+	for _, elves := range coverage {
+		if elves > most {
+			most = elves
+		}
+	}
+	if report.MaxCoverage != most {
+		t.Errorf("max coverage = %d, want %d", report.MaxCoverage, most)
+	}
+	for _, busiest := range report.MaxCoverageSections {
+		for section := busiest.Start; section <= busiest.End; section++ {
+			if coverage[section] != most {
+				t.Errorf("section %d has %d elves, want %d", section, coverage[section], most)
+			}
+		}
+	}
+
+	if report.CoveredLength != len(coverage) {
+		t.Errorf("covered length = %d, want %d", report.CoveredLength, len(coverage))
+	}
+	for _, uncovered := range report.Uncovered {
+		for section := uncovered.Start; section <= uncovered.End; section++ {
+			if coverage[section] != 0 {
+				t.Errorf("section %d is covered", section)
+			}
+		}
+	}
+	if got := report.CoveredLength + interval.Length(report.Uncovered); got != high-low+1 {
+		t.Errorf("covered and uncovered sections add up to %d, want %d", got, high-low+1)
+	}
+
+	var pairs []*ElfPair
+	for a := range assignments {
+		for b := a + 1; b < len(assignments); b++ {
+			if overlap, ok := assignments[a].Sections.Intersect(assignments[b].Sections); ok {
+				pairs = append(pairs, &ElfPair{First: assignments[a], Second: assignments[b], Overlap: overlap})
+			}
+		}
+	}
+	if !reflect.DeepEqual(report.OverlappingPairs, pairs) {
+		t.Errorf("found %d overlapping pairs, want %d", len(report.OverlappingPairs), len(pairs))
+	}
+	if report.OverlappingPairCount != len(pairs) {
+		t.Errorf("counted %d overlapping pairs, want %d", report.OverlappingPairCount, len(pairs))
+	}
+}
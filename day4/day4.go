@@ -0,0 +1,113 @@
+package day4
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/interval"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func init() {
+	puzzle.Register(4, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver compares the section assignments of the elves in each group.
+type Solver struct {
+	groups []*GroupReport
+}
+
+// getSectionAssignment parses the sections assigned to an elf, like 2-4.
+func getSectionAssignment(assignmentStr string) (interval.Interval, error) {
+	assignmentValues := strings.Split(assignmentStr, "-")
+	if len(assignmentValues) != 2 {
+		return interval.Interval{}, fmt.Errorf("found %d section numbers", len(assignmentValues))
+	}
+
+	start, err := strconv.Atoi(assignmentValues[0])
+	if err != nil {
+		return interval.Interval{}, err
+	}
+
+	end, err := strconv.Atoi(assignmentValues[1])
+	if err != nil {
+		return interval.Interval{}, err
+	}
+
+	return interval.New(start, end)
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	groups, err := ReadAssignments(r)
+	if err != nil {
+		return err
+	}
+
+	s.groups = nil
+	for _, group := range groups {
+		s.groups = append(s.groups, AnalyzeGroup(group))
+	}
+
+	return nil
+}
+
+// ReadAssignments returns the section assignments of the group of elves on
+// each line, which are separated by commas.
+func ReadAssignments(r io.Reader) ([][]interval.Interval, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups [][]interval.Interval
+	for idx, line := range lines {
+		var group []interval.Interval
+
+		column := 1
+		for _, assignmentStr := range strings.Split(line, ",") {
+			assignment, err := getSectionAssignment(assignmentStr)
+			if err != nil {
+				return nil, &input.ParseError{
+					Day:      4,
+					Line:     idx + 1,
+					Column:   column,
+					Text:     line,
+					Expected: "a section assignment like 2-4, which doesn't end before it starts",
+					Err:      err,
+				}
+			}
+
+			group = append(group, assignment)
+			column += len(assignmentStr) + 1
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// Part1 returns the number of pairs of elves in the same group where one
+// assignment fully contains the other.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	nestedPairs := 0
+	for _, group := range s.groups {
+		nestedPairs += group.NestedPairs
+	}
+
+	return puzzle.Int(nestedPairs), nil
+}
+
+// Part2 returns the number of pairs of elves in the same group whose
+// assignments overlap.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	overlappingPairs := 0
+	for _, group := range s.groups {
+		overlappingPairs += group.OverlappingPairs
+	}
+
+	return puzzle.Int(overlappingPairs), nil
+}
@@ -0,0 +1,66 @@
+package day4
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/darthchudi/aoc2022/interval"
+)
+
+// GroupReport compares the assignments of a group of elves sharing a line.
+type GroupReport struct {
+	Assignments      []interval.Interval `json:"assignments"`
+	NestedPairs      int                 `json:"nestedPairs"`      // pairs where one assignment contains the other
+	OverlappingPairs int                 `json:"overlappingPairs"` // pairs whose assignments overlap
+
+	// Common is the sections every elf in the group is assigned to, if
+	// HasCommon is true.
+	Common    interval.Interval `json:"common"`
+	HasCommon bool              `json:"hasCommon"`
+}
+
+// AnalyzeGroup compares every pair of assignments in a group.
+func AnalyzeGroup(assignments []interval.Interval) *GroupReport {
+	group := &GroupReport{Assignments: assignments}
+
+	for a := range assignments {
+		for b := a + 1; b < len(assignments); b++ {
+			if assignments[a].Contains(assignments[b]) || assignments[b].Contains(assignments[a]) {
+				group.NestedPairs++
+			}
+			if assignments[a].Overlaps(assignments[b]) {
+				group.OverlappingPairs++
+			}
+		}
+	}
+
+	if len(assignments) > 0 {
+		group.Common, group.HasCommon = assignments[0], true
+		for _, assignment := range assignments[1:] {
+			group.Common, group.HasCommon = group.Common.Intersect(assignment)
+			if !group.HasCommon {
+				break
+			}
+		}
+	}
+
+	return group
+}
+
+// WriteGroups writes a line of aligned text for each group.
+func WriteGroups(w io.Writer, groups []*GroupReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "line\telves\tnested\toverlapping\tcommon")
+	for idx, group := range groups {
+		common := "-"
+		if group.HasCommon {
+			common = group.Common.String()
+		}
+
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%s\n", idx+1, len(group.Assignments), group.NestedPairs, group.OverlappingPairs, common)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,68 @@
+package day4
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/interval"
+)
+
+func TestAnalyzeGroup(t *testing.T) {
+	tests := []struct {
+		line        string
+		nested      int
+		overlapping int
+		common      interval.Interval
+		hasCommon   bool
+	}{
+		{"2-4,6-8", 0, 0, interval.Interval{}, false},
+		{"2-8,3-7", 1, 1, interval.Interval{Start: 3, End: 7}, true},
+		{"1-10,2-5,4-9,5-5", 5, 6, interval.Interval{Start: 5, End: 5}, true},
+		{"1-3,3-5,5-7", 0, 2, interval.Interval{}, false},
+		{"4-6", 0, 0, interval.Interval{Start: 4, End: 6}, true},
+	}
+
+	for _, test := range tests {
+		groups, err := ReadAssignments(strings.NewReader(test.line))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		group := AnalyzeGroup(groups[0])
+		if group.NestedPairs != test.nested || group.OverlappingPairs != test.overlapping {
+			t.Errorf("%s: %d nested and %d overlapping, want %d and %d", test.line, group.NestedPairs, group.OverlappingPairs, test.nested, test.overlapping)
+		}
+		if group.HasCommon != test.hasCommon || group.Common != test.common {
+			t.Errorf("%s: common sections %v (%t), want %v (%t)", test.line, group.Common, group.HasCommon, test.common, test.hasCommon)
+		}
+	}
+}
+
+func TestReadAssignmentsErrors(t *testing.T) {
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"2-4,8-6", 5},
+		{"4-2", 1},
+		{"2-4,,6-8", 5},
+		{"2-4,6-8,x-9", 9},
+		{"2-4-6,6-8", 1},
+		{",2-4", 1},
+	}
+
+	for _, test := range tests {
+		_, err := ReadAssignments(strings.NewReader("1-2,3-4\n" + test.line))
+
+		var parseError *input.ParseError
+		if !errors.As(err, &parseError) {
+			t.Errorf("%q: err = %v, want a parse error", test.line, err)
+			continue
+		}
+		if parseError.Line != 2 || parseError.Column != test.column {
+			t.Errorf("%q: error at %d:%d, want 2:%d", test.line, parseError.Line, parseError.Column, test.column)
+		}
+	}
+}
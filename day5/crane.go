@@ -0,0 +1,97 @@
+package day5
+
+import "fmt"
+
+// Crane moves crates between stacks. Stacks are listed from the top crate
+// down.
+type Crane interface {
+	Name() string
+
+	// Move moves the top count crates of source onto destination, returning
+	// both stacks afterwards. The stacks passed in are left unchanged.
+	Move(source, destination []string, count int) (updatedSource, updatedDestination []string)
+}
+
+// cranes are the registered cranes, in the order they were registered.
+var cranes []Crane
+
+func init() {
+	RegisterCrane(CrateMover9000{})
+	RegisterCrane(CrateMover9001{})
+}
+
+// RegisterCrane adds a crane to the ones returned by Cranes.
+func RegisterCrane(crane Crane) {
+	cranes = append(cranes, crane)
+}
+
+// Cranes returns every registered crane.
+func Cranes() []Crane {
+	return append([]Crane(nil), cranes...)
+}
+
+// CrateMover9000 moves one crate at a time, so the crates it moves end up in
+// reverse order.
+type CrateMover9000 struct{}
+
+func (CrateMover9000) Name() string { return "CrateMover 9000" }
+
+func (CrateMover9000) Move(source, destination []string, count int) ([]string, []string) {
+	for i := 0; i < count; i++ {
+		source, destination = lift(source, destination, 1)
+	}
+
+	return source, destination
+}
+
+// CrateMover9001 moves all the crates at once, so they keep their order.
+type CrateMover9001 struct{}
+
+func (CrateMover9001) Name() string { return "CrateMover 9001" }
+
+func (CrateMover9001) Move(source, destination []string, count int) ([]string, []string) {
+	return lift(source, destination, count)
+}
+
+// CrateMover moves up to MaxLift crates at a time, so each lift keeps its
+// order but the lifts end up in reverse order. It behaves like the
+// CrateMover 9000 with a MaxLift of 1.
+type CrateMover struct {
+	MaxLift int
+}
+
+// NewCrateMover returns a crane that moves up to maxLift crates at a time.
+func NewCrateMover(maxLift int) (*CrateMover, error) {
+	if maxLift < 1 {
+		return nil, fmt.Errorf("a crane must lift at least 1 crate, not %d", maxLift)
+	}
+
+	return &CrateMover{MaxLift: maxLift}, nil
+}
+
+func (c *CrateMover) Name() string {
+	return fmt.Sprintf("CrateMover (%d per lift)", c.MaxLift)
+}
+
+func (c *CrateMover) Move(source, destination []string, count int) ([]string, []string) {
+	for count > 0 {
+		crates := count
+		if crates > c.MaxLift {
+			crates = c.MaxLift
+		}
+
+		source, destination = lift(source, destination, crates)
+		count -= crates
+	}
+
+	return source, destination
+}
+
+// lift moves the top count crates of source onto destination in one go,
+// keeping their order.
+func lift(source, destination []string, count int) ([]string, []string) {
+	updatedSource := append([]string{}, source[count:]...)
+	updatedDestination := append(append([]string{}, source[:count]...), destination...)
+
+	return updatedSource, updatedDestination
+}
@@ -0,0 +1,37 @@
+package day5
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCranes(t *testing.T) {
+	source := []string{"A", "B", "C", "D", "E"}
+	destination := []string{"X"}
+
+	tests := []struct {
+		crane Crane
+		want  []string
+	}{
+		{CrateMover9000{}, []string{"D", "C", "B", "A", "X"}},
+		{CrateMover9001{}, []string{"A", "B", "C", "D", "X"}},
+		{&CrateMover{MaxLift: 1}, []string{"D", "C", "B", "A", "X"}},
+		{&CrateMover{MaxLift: 3}, []string{"D", "A", "B", "C", "X"}},
+		{&CrateMover{MaxLift: 4}, []string{"A", "B", "C", "D", "X"}},
+	}
+
+	for _, test := range tests {
+		updatedSource, updatedDestination := test.crane.Move(source, destination, 4)
+
+		if !reflect.DeepEqual(updatedSource, []string{"E"}) {
+			t.Errorf("%s: source = %v, want [E]", test.crane.Name(), updatedSource)
+		}
+		if !reflect.DeepEqual(updatedDestination, test.want) {
+			t.Errorf("%s: destination = %v, want %v", test.crane.Name(), updatedDestination, test.want)
+		}
+	}
+
+	if !reflect.DeepEqual(source, []string{"A", "B", "C", "D", "E"}) || !reflect.DeepEqual(destination, []string{"X"}) {
+		t.Errorf("the stacks passed in were changed to %v and %v", source, destination)
+	}
+}
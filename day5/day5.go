@@ -0,0 +1,258 @@
+package day5
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func init() {
+	puzzle.Register(5, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver rearranges the stacks of crates following the procedure.
+type Solver struct {
+	crates       CrateConfig
+	instructions []*Instruction
+}
+
+type Instruction struct {
+	count       int
+	source      int
+	destination int
+}
+
+type CrateConfig map[int][]string
+
+var (
+	instructionRegex = regexp.MustCompile("^move ([0-9]+) from ([0-9]+) to ([0-9]+)$")
+	crateColumnLine  = regexp.MustCompile(`^ [0-9]+`)
+)
+
+func parseInstructions(procedure *input.Block, maxColumns int) ([]*Instruction, error) {
+	var instructions []*Instruction
+
+	for lineIdx, line := range procedure.Lines {
+		lineNumber := procedure.Line + lineIdx
+
+		// Pairs of start and end indexes of the full match and each submatch
+		indexes := instructionRegex.FindStringSubmatchIndex(line)
+		if indexes == nil {
+			return nil, &input.ParseError{
+				Day:      5,
+				Line:     lineNumber,
+				Text:     line,
+				Expected: `an instruction like "move 1 from 2 to 1"`,
+			}
+		}
+
+		var values []int
+		for i := 1; i <= 3; i++ {
+			start, end := indexes[2*i], indexes[2*i+1]
+
+			value, err := strconv.Atoi(line[start:end])
+			if err != nil {
+				return nil, &input.ParseError{
+					Day:      5,
+					Line:     lineNumber,
+					Column:   start + 1,
+					Text:     line,
+					Expected: "a number",
+					Err:      err,
+				}
+			}
+
+			isStack := i > 1
+			if isStack && (value < 1 || value > maxColumns) {
+				return nil, &input.ParseError{
+					Day:      5,
+					Line:     lineNumber,
+					Column:   start + 1,
+					Text:     line,
+					Expected: fmt.Sprintf("a stack from 1 to %d", maxColumns),
+				}
+			}
+
+			values = append(values, value)
+		}
+
+		instruction := &Instruction{
+			count:       values[0],
+			source:      values[1],
+			destination: values[2],
+		}
+
+		instructions = append(instructions, instruction)
+	}
+
+	return instructions, nil
+}
+
+// parseCrates reads the starting stacks from the drawing, returning the
+// crates of each stack from top to bottom along with the number of stacks.
+func parseCrates(drawing *input.Block) (CrateConfig, int, error) {
+	crates := map[int][]string{}
+
+	crateLines := drawing.Lines[:len(drawing.Lines)-1]
+	columnLine := drawing.Lines[len(drawing.Lines)-1]
+	columnLineNumber := drawing.Line + len(drawing.Lines) - 1
+
+	columns := strings.Fields(columnLine)
+	if !crateColumnLine.MatchString(columnLine) {
+		return nil, 0, &input.ParseError{
+			Day:      5,
+			Line:     columnLineNumber,
+			Text:     columnLine,
+			Expected: `the stack numbers below the crates, like " 1   2   3 "`,
+		}
+	}
+
+	maxColumns, err := strconv.Atoi(columns[len(columns)-1])
+	if err != nil {
+		return nil, 0, &input.ParseError{
+			Day:      5,
+			Line:     columnLineNumber,
+			Column:   strings.LastIndex(columnLine, columns[len(columns)-1]) + 1,
+			Text:     columnLine,
+			Expected: "the number of the last stack",
+			Err:      err,
+		}
+	}
+
+	characterRegex := regexp.MustCompile(`([A-Z]+)`)
+
+	// Map of the index of the crate to the column number
+	chracterIndexToCrateNumber := map[int]int{}
+
+	currentCharacterIndex := 1
+	for i := 0; i < maxColumns; i++ {
+		chracterIndexToCrateNumber[currentCharacterIndex] = i + 1
+		currentCharacterIndex += 4 // 4 characters per crate including spaces
+
+		// Start with every stack empty so stacks without crates are kept
+		crates[i+1] = nil
+	}
+
+	for lineIdx, line := range crateLines {
+		for idx, character := range line {
+			if characterRegex.MatchString(string(character)) {
+				crateNumber, ok := chracterIndexToCrateNumber[idx]
+				if !ok {
+					return nil, 0, &input.ParseError{
+						Day:      5,
+						Line:     drawing.Line + lineIdx,
+						Column:   idx + 1,
+						Text:     line,
+						Expected: "a crate lined up with one of the stack numbers",
+					}
+				}
+				crates[crateNumber] = append(crates[crateNumber], string(character))
+			}
+		}
+	}
+
+	return crates, maxColumns, nil
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	blocks, err := input.Blocks(r)
+	if err != nil {
+		return err
+	}
+
+	// The drawing of the stacks is separated from the procedure by a blank line
+	if len(blocks) != 2 {
+		parseError := &input.ParseError{
+			Day:      5,
+			Line:     1,
+			Expected: "a drawing of the stacks and the rearrangement procedure separated by a blank line",
+		}
+		if len(blocks) > 2 {
+			parseError.Line = blocks[2].Line
+			parseError.Text = blocks[2].Lines[0]
+		}
+
+		return parseError
+	}
+
+	crates, maxColumns, err := parseCrates(blocks[0])
+	if err != nil {
+		return err
+	}
+
+	instructions, err := parseInstructions(blocks[1], maxColumns)
+	if err != nil {
+		return err
+	}
+
+	s.crates = crates
+	s.instructions = instructions
+
+	return nil
+}
+
+// Part1 returns the crates on top of each stack when they are moved one at
+// a time.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	result, err := s.Rearrange(CrateMover9000{})
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	return puzzle.Text(result), nil
+}
+
+// Part2 returns the crates on top of each stack when they are moved all at
+// once.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	result, err := s.Rearrange(CrateMover9001{})
+	if err != nil {
+		return puzzle.Answer{}, err
+	}
+
+	return puzzle.Text(result), nil
+}
+
+// Rearrange carries out the instructions with a crane on a copy of the
+// stacks, and returns the crates that end up on top of each stack. Stacks
+// that end up empty are left out.
+func (s *Solver) Rearrange(crane Crane) (string, error) {
+	crates := CrateConfig{}
+	for key, stack := range s.crates {
+		crates[key] = stack
+	}
+
+	for idx, instruction := range s.instructions {
+		source := crates[instruction.source]
+		destination := crates[instruction.destination]
+
+		if instruction.count > len(source) {
+			return "", fmt.Errorf("instruction %d moves %d crates from stack %d which only has %d", idx+1, instruction.count, instruction.source, len(source))
+		}
+
+		crates[instruction.source], crates[instruction.destination] = crane.Move(source, destination, instruction.count)
+	}
+
+	keys := []int{}
+	for key, _ := range crates {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	result := ""
+	for _, key := range keys {
+		if len(crates[key]) == 0 {
+			continue
+		}
+
+		result += crates[key][0]
+	}
+
+	return result, nil
+}
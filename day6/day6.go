@@ -0,0 +1,80 @@
+package day6
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func init() {
+	puzzle.Register(6, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver finds the markers in the communication device's data stream.
+type Solver struct {
+	dataStream string
+}
+
+func hasUniqueCharacters(input []rune) bool {
+	seen := map[rune]bool{}
+
+	for _, character := range input {
+		if seen[character] {
+			return false
+		}
+
+		seen[character] = true
+	}
+
+	return true
+}
+
+func (s *Solver) Parse(r io.Reader) error {
+	dataStream, err := input.Read(r)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %v", err)
+	}
+
+	s.dataStream = dataStream
+
+	return nil
+}
+
+// Part1 returns the number of characters processed before the first
+// start-of-packet marker.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	return puzzle.Int(findMarker(s.dataStream, 4)), nil
+}
+
+// Part2 returns the number of characters processed before the first
+// start-of-message marker.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	return puzzle.Int(findMarker(s.dataStream, 14)), nil
+}
+
+// findMarker returns the number of characters processed once the last
+// sequenceLength characters are all different.
+func findMarker(dataStream string, sequenceLength int) int {
+	var stack []rune
+	resultIndex := 0
+	start := 0
+
+	for index, character := range dataStream {
+		stack = append(stack, character)
+
+		if len(stack) >= sequenceLength {
+			mostRecentCharactersInSequence := stack[start : index+1]
+
+			if hasUniqueCharacters(mostRecentCharactersInSequence) {
+				resultIndex = index
+				break
+			}
+
+			start += 1 // move the start index forward
+		}
+	}
+
+	return resultIndex + 1
+}
@@ -1,15 +1,27 @@
-package main
+package day7
 
 import (
-	"bufio"
 	"fmt"
+	"io"
 	"log"
-	"os"
 	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
 )
 
+func init() {
+	puzzle.Register(7, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver rebuilds the filesystem from the terminal output.
+type Solver struct {
+	rootDirectory *Directory
+	lookup        map[string]*Directory // map of path to directory
+}
+
 type Content interface {
 	isDirectory() bool
 	getName() string
@@ -68,6 +80,9 @@ func (d *Directory) getSize() int64 {
 
 func parseFile(input string) (*File, error) {
 	values := strings.Split(input, " ")
+	if len(values) != 2 {
+		return nil, fmt.Errorf("found %d fields instead of a size and a name", len(values))
+	}
 
 	fileSize, err := strconv.Atoi(values[0])
 	if err != nil {
@@ -82,23 +97,30 @@ func parseFile(input string) (*File, error) {
 	return file, nil
 }
 
-func main() {
-	file, err := os.Open("input.txt")
-	if err != nil {
-		log.Fatalf("failed to open file: %v", err)
-	}
-	defer file.Close()
-
+func (s *Solver) Parse(r io.Reader) error {
 	var currentPaths []string
 	var rootDirectory *Directory
 	lookup := map[string]*Directory{}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		value := scanner.Text()
+	lines, err := input.Lines(r)
+	if err != nil {
+		return err
+	}
+
+	for idx, value := range lines {
 		isCommand := strings.HasPrefix(value, "$")
 
 		if isCommand {
+			if !strings.HasPrefix(value, "$ ") {
+				return &input.ParseError{
+					Day:      7,
+					Line:     idx + 1,
+					Column:   2,
+					Text:     value,
+					Expected: "a space between $ and the command",
+				}
+			}
+
 			// trim the $ prefix
 			command := value[2:]
 
@@ -107,9 +129,28 @@ func main() {
 			case strings.HasPrefix(command, "cd"):
 				// We are changing directory using the cd <path> command
 				values := strings.Split(command, " ")
+				if len(values) != 2 {
+					return &input.ParseError{
+						Day:      7,
+						Line:     idx + 1,
+						Column:   3,
+						Text:     value,
+						Expected: "a single path after cd",
+					}
+				}
 				path := values[1]
 
 				if path == ".." {
+					if len(currentPaths) == 0 {
+						return &input.ParseError{
+							Day:      7,
+							Line:     idx + 1,
+							Column:   6,
+							Text:     value,
+							Expected: "a directory to go up from, but no directory has been entered",
+						}
+					}
+
 					// We are going up a directory
 					currentPaths = currentPaths[:len(currentPaths)-1]
 					continue
@@ -136,13 +177,28 @@ func main() {
 				}
 
 				// Add the new directory to root of the previous path
-				previousDirectory := lookup[previousKey]
+				previousDirectory, ok := lookup[previousKey]
+				if !ok {
+					return &input.ParseError{
+						Day:      7,
+						Line:     idx + 1,
+						Column:   6,
+						Text:     value,
+						Expected: "a cd into a directory inside the one above, but there's no directory above",
+					}
+				}
 				previousDirectory.files = append(previousDirectory.files, directory)
 			case strings.HasPrefix(command, "ls"):
 				// We don't need to do anything for the ls command
 				continue
 			default:
-				log.Fatalf("unknown command: %v", command)
+				return &input.ParseError{
+					Day:      7,
+					Line:     idx + 1,
+					Column:   3,
+					Text:     value,
+					Expected: "a cd or ls command",
+				}
 			}
 		} else {
 			// Handle command line output
@@ -154,21 +210,49 @@ func main() {
 			// We are dealing with a file
 			file, err := parseFile(value)
 			if err != nil {
-				log.Fatalf("failed to parse file: %v", err)
+				return &input.ParseError{
+					Day:      7,
+					Line:     idx + 1,
+					Text:     value,
+					Expected: `a directory like "dir a" or a file like "14848514 b.txt"`,
+					Err:      err,
+				}
 			}
 
 			key := strings.Join(currentPaths, "->")
 			currentDirectory, ok := lookup[key]
 			if !ok {
-				log.Fatalf("failed to find current directory: %v", key)
+				return &input.ParseError{
+					Day:      7,
+					Line:     idx + 1,
+					Text:     value,
+					Expected: "a cd into a directory before its contents are listed",
+				}
 			}
 
 			currentDirectory.files = append(currentDirectory.files, file)
 		}
 	}
 
+	if rootDirectory == nil {
+		return &input.ParseError{
+			Day:      7,
+			Line:     1,
+			Expected: "a cd into the root directory",
+		}
+	}
+
+	s.rootDirectory = rootDirectory
+	s.lookup = lookup
+
+	return nil
+}
+
+// Part1 returns the total size of all directories with a size of at most
+// 100000.
+func (s *Solver) Part1() (puzzle.Answer, error) {
 	total := 0
-	for _, v := range lookup {
+	for _, v := range s.lookup {
 		size := v.getSize()
 
 		if size >= 100000 {
@@ -177,15 +261,20 @@ func main() {
 
 		total += int(size)
 	}
-	fmt.Printf("total size of all directories with a size of at most 100000: %v\n", total)
 
+	return puzzle.Int(total), nil
+}
+
+// Part2 returns the size of the smallest directory that frees up enough
+// space for the update when deleted.
+func (s *Solver) Part2() (puzzle.Answer, error) {
 	totalSpaceAvailable := 70000000
 	updateSize := 30000000
-	unusedSpace := totalSpaceAvailable - int(rootDirectory.getSize())
+	unusedSpace := totalSpaceAvailable - int(s.rootDirectory.getSize())
 	minimumSpaceNeededForUpdate := updateSize - unusedSpace
 
 	var eligibleDirectories []*Directory
-	for key, directory := range lookup {
+	for key, directory := range s.lookup {
 		if key == "/" {
 			// Skip the root directory
 			continue
@@ -203,7 +292,11 @@ func main() {
 		return eligibleDirectories[i].getSize() < eligibleDirectories[j].getSize()
 	})
 
+	if len(eligibleDirectories) == 0 {
+		return puzzle.Answer{}, fmt.Errorf("no directory frees up %v for the update", minimumSpaceNeededForUpdate)
+	}
+
 	selectedDirectory := eligibleDirectories[0]
 
-	fmt.Printf("Deleting directory %v which has size of %v\n", selectedDirectory.name, selectedDirectory.getSize())
+	return puzzle.Int(int(selectedDirectory.getSize())), nil
 }
@@ -0,0 +1,34 @@
+package day7
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/input"
+)
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		text string
+		line int
+	}{
+		{"$ cd ..\n", 1},
+		{"$ cd /\n$ cd ..\n$ cd a\n", 3},
+		{"$ cd /\n$ cd ..\n$ cd ..\n", 3},
+		{"14848514 b.txt\n", 1},
+		{"$cd /\n", 1},
+		{"$ cd /\n$ rm -rf a\n", 2},
+	} {
+		err := (&Solver{}).Parse(strings.NewReader(test.text))
+
+		var parseError *input.ParseError
+		if !errors.As(err, &parseError) {
+			t.Errorf("Parse(%q) = %v, want a parse error", test.text, err)
+			continue
+		}
+		if parseError.Line != test.line {
+			t.Errorf("Parse(%q) error on line %d, want line %d", test.text, parseError.Line, test.line)
+		}
+	}
+}
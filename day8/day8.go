@@ -1,16 +1,30 @@
-package main
+package day8
 
 import (
-	"bufio"
 	"fmt"
-	"log"
-	"os"
+	"io"
 	"sort"
-	"strconv"
-	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
 )
 
+func init() {
+	puzzle.Register(8, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver surveys the heights of the trees in the grid.
+type Solver struct {
+	rows [][]int
+}
+
 func getVisibleTreesAtEdge(rows [][]int) int {
+	// A grid that's a single row or column deep is all edge, and its edges
+	// would otherwise overlap
+	if len(rows) < 2 || len(rows[0]) < 2 {
+		return len(rows) * len(rows[0])
+	}
+
 	// Get the trees at the left edge
 	var leftEdgeTrees []int
 	for _, row := range rows {
@@ -33,7 +47,8 @@ func getVisibleTreesAtEdge(rows [][]int) int {
 	}
 
 	// Get the trees at the bottom edge
-	bottomEdgeTrees := rows[len(rows)-1][1 : len(rows)-1]
+	bottomRow := rows[len(rows)-1]
+	bottomEdgeTrees := bottomRow[1 : len(bottomRow)-1]
 
 	sumOfTreesAtEdge := len(leftEdgeTrees) + len(topEdgeTrees) + len(rightEdgeTrees) + len(bottomEdgeTrees)
 	return sumOfTreesAtEdge
@@ -169,42 +184,41 @@ func getScenicScores(rows [][]int) []int {
 	return scenicScores
 }
 
-func main() {
-	file, err := os.Open("input.txt")
+func (s *Solver) Parse(r io.Reader) error {
+	rows, err := input.DigitGrid(r)
 	if err != nil {
-		log.Fatalf("failed to open file: %v", err)
+		return err
 	}
-	defer file.Close()
 
-	var rows [][]int
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return &input.ParseError{
+			Day:      8,
+			Line:     1,
+			Expected: "a grid of tree heights",
+		}
+	}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		var trees []int
+	s.rows = rows
 
-		line := scanner.Text()
-		splitTrees := strings.Split(line, "")
+	return nil
+}
 
-		for _, treeStr := range splitTrees {
-			tree, err := strconv.Atoi(treeStr)
-			if err != nil {
-				log.Fatalf("failed to parse tree: %v", err)
-			}
+// Part1 returns the number of trees visible from outside the grid.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	sumOfTreesAtEdge := getVisibleTreesAtEdge(s.rows)
+	visibleInteriorTrees := getVisibleInteriorTrees(s.rows)
 
-			trees = append(trees, tree)
-		}
+	return puzzle.Int(visibleInteriorTrees + sumOfTreesAtEdge), nil
+}
 
-		rows = append(rows, trees)
+// Part2 returns the highest scenic score of any tree.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	scenicScores := getScenicScores(s.rows)
+	if len(scenicScores) == 0 {
+		return puzzle.Answer{}, fmt.Errorf("grid has no interior trees")
 	}
 
-	sumOfTreesAtEdge := getVisibleTreesAtEdge(rows)
-	visibleInteriorTrees := getVisibleInteriorTrees(rows)
-	scenicScores := getScenicScores(rows)
 	sort.Ints(scenicScores)
 
-	fmt.Println("Sum of trees at edge: ", sumOfTreesAtEdge)
-	fmt.Println("Visible interior trees: ", visibleInteriorTrees)
-
-	fmt.Println("Total visible trees: ", visibleInteriorTrees+sumOfTreesAtEdge)
-	fmt.Println("Highest scenic score: ", scenicScores[len(scenicScores)-1])
+	return puzzle.Int(scenicScores[len(scenicScores)-1]), nil
 }
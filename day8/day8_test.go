@@ -0,0 +1,49 @@
+package day8
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+func TestVisibleTrees(t *testing.T) {
+	for _, test := range []struct {
+		text string
+		want int
+	}{
+		{"123\n", 3},
+		{"1\n2\n3\n", 3},
+		{"5\n", 1},
+		{"12\n34\n", 4},
+		// Every tree on the edge of a 3x5 grid, plus the two 5s of the middle row
+		{"30373\n25512\n65332\n", 14},
+		{"30373\n25512\n65332\n33549\n35390\n", 21},
+	} {
+		solver := &Solver{}
+		if err := solver.Parse(strings.NewReader(test.text)); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := solver.Part1()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := puzzle.Int(test.want); got != want {
+			t.Errorf("Part1(%q) = %v, want %v", test.text, got, want)
+		}
+	}
+}
+
+func TestNoInteriorTrees(t *testing.T) {
+	for _, text := range []string{"123\n", "1\n2\n3\n", "12\n34\n"} {
+		solver := &Solver{}
+		if err := solver.Parse(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := solver.Part2(); err == nil {
+			t.Errorf("Part2(%q) expected an error for a grid with no interior trees", text)
+		}
+	}
+}
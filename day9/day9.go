@@ -1,15 +1,27 @@
-package main
+package day9
 
 import (
-	"bufio"
 	"fmt"
-	"log"
+	"io"
 	"math"
-	"os"
+	"regexp"
 	"strconv"
-	"strings"
+
+	"github.com/darthchudi/aoc2022/input"
+	"github.com/darthchudi/aoc2022/puzzle"
 )
 
+func init() {
+	puzzle.Register(9, func() puzzle.Solver { return &Solver{} })
+}
+
+// Solver simulates the rope as its head follows the series of moves.
+type Solver struct {
+	moves []*Move
+}
+
+var moveRegex = regexp.MustCompile(`^([UDLR]) ([0-9]+)$`)
+
 type Move struct {
 	direction string
 	count     int
@@ -182,24 +194,25 @@ func getGridOutput(grid [][]int, headPosition *Position, tailPosition *Position,
 	return output
 }
 
-func main() {
-	file, err := os.Open("test.txt")
-	if err != nil {
-		log.Fatalf("failed to open file: %s", err)
-	}
-	defer file.Close()
-
+func (s *Solver) Parse(r io.Reader) error {
 	var moves []*Move
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-
-		parts := strings.Split(line, " ")
+	records, err := input.Records(r, moveRegex)
+	if err != nil {
+		return err
+	}
 
+	for idx, parts := range records {
 		count, err := strconv.Atoi(parts[1])
 		if err != nil {
-			log.Fatalf("failed to convert to int: %s", err)
+			return &input.ParseError{
+				Day:      9,
+				Line:     idx + 1,
+				Column:   3,
+				Text:     parts[0] + " " + parts[1],
+				Expected: "the number of steps to move",
+				Err:      err,
+			}
 		}
 
 		move := &Move{
@@ -209,6 +222,29 @@ func main() {
 		moves = append(moves, move)
 	}
 
+	s.moves = moves
+
+	return nil
+}
+
+// Part1 returns the number of positions visited by the tail of a two knot
+// rope.
+func (s *Solver) Part1() (puzzle.Answer, error) {
+	tailVisitedPositions, _ := simulateRope(s.moves)
+	return puzzle.Int(len(tailVisitedPositions)), nil
+}
+
+// Part2 returns the number of positions visited by the tail of a ten knot
+// rope.
+func (s *Solver) Part2() (puzzle.Answer, error) {
+	_, lastKnotVisitedPositions := simulateRope(s.moves)
+	return puzzle.Int(len(lastKnotVisitedPositions)), nil
+}
+
+// simulateRope moves the head of the rope and returns the positions visited
+// by the tail of a two knot rope and by the last knot of a ten knot rope.
+func simulateRope(moves []*Move) (map[string]bool, map[string]bool) {
+
 	maxVerticalMove := 0
 	maxHorizontalMove := 0
 	for _, move := range moves {
@@ -304,14 +340,11 @@ func main() {
 		}
 	}
 
-	shouldPrintGridOutput := true
-	fmt.Println("Visited positions by tail knot: ", len(tailVisitedPositions))
-	fmt.Println("Visited positions by last knot: ", len(lastKnotVisitedPositions))
-
-	if !shouldPrintGridOutput {
-		return
+	shouldPrintGridOutput := false
+	if shouldPrintGridOutput {
+		output := getGridOutput(grid, headPosition, tailPosition, tailVisitedPositions, knots)
+		fmt.Println(output)
 	}
 
-	output := getGridOutput(grid, headPosition, tailPosition, tailVisitedPositions, knots)
-	fmt.Println(output)
+	return tailVisitedPositions, lastKnotVisitedPositions
 }
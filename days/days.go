@@ -0,0 +1,18 @@
+// Package days registers the solver for every day of the advent calendar.
+// Import it for its side effects to make all of them available in the
+// puzzle registry.
+package days
+
+import (
+	_ "github.com/darthchudi/aoc2022/day1"
+	_ "github.com/darthchudi/aoc2022/day10"
+	_ "github.com/darthchudi/aoc2022/day11"
+	_ "github.com/darthchudi/aoc2022/day2"
+	_ "github.com/darthchudi/aoc2022/day3"
+	_ "github.com/darthchudi/aoc2022/day4"
+	_ "github.com/darthchudi/aoc2022/day5"
+	_ "github.com/darthchudi/aoc2022/day6"
+	_ "github.com/darthchudi/aoc2022/day7"
+	_ "github.com/darthchudi/aoc2022/day8"
+	_ "github.com/darthchudi/aoc2022/day9"
+)
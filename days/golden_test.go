@@ -0,0 +1,187 @@
+package days
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/darthchudi/aoc2022/puzzle"
+)
+
+// Each day keeps its example inputs in testdata/dayN/NAME.txt, and the
+// expected answers for both parts in testdata/dayN/NAME.golden:
+//
+//	-- part 1 --
+//	24000
+//	-- part 2 --
+//	45000
+//
+// Run the tests with -update to rewrite the golden files from the current
+// answers after a deliberate change.
+var update = flag.Bool("update", false, "rewrite the golden answers with the current answers")
+
+var goldenSectionRegex = regexp.MustCompile(`^-- part ([0-9]+) --$`)
+
+func TestGolden(t *testing.T) {
+	for _, day := range puzzle.Days() {
+		day := day
+		t.Run(fmt.Sprintf("day%d", day), func(t *testing.T) {
+			examples, err := filepath.Glob(filepath.Join("testdata", fmt.Sprintf("day%d", day), "*.txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// A newly generated day has no examples until they are added
+			if len(examples) == 0 {
+				t.Skipf("no example inputs in testdata/day%d", day)
+			}
+
+			for _, example := range examples {
+				example := example
+				name := strings.TrimSuffix(filepath.Base(example), ".txt")
+				t.Run(name, func(t *testing.T) {
+					testExample(t, day, example)
+				})
+			}
+		})
+	}
+}
+
+func testExample(t *testing.T, day int, example string) {
+	solver, _ := puzzle.Lookup(day)
+
+	file, err := os.Open(example)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if err := solver.Parse(file); err != nil {
+		t.Fatalf("failed to parse %s: %v", example, err)
+	}
+
+	got := map[int]string{}
+	for _, part := range []int{1, 2} {
+		answer, err := puzzle.Solve(solver, part)
+		if err != nil {
+			t.Errorf("part %d: %v", part, err)
+			continue
+		}
+
+		got[part] = answer.String()
+	}
+
+	goldenFile := strings.TrimSuffix(example, ".txt") + ".golden"
+	if *update {
+		if err := ioutil.WriteFile(goldenFile, formatGolden(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	b, err := ioutil.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatalf("failed to read golden answers (run with -update to create them): %v", err)
+	}
+
+	want, err := parseGolden(string(b))
+	if err != nil {
+		t.Fatalf("%s: %v", goldenFile, err)
+	}
+
+	for _, part := range []int{1, 2} {
+		if got[part] != want[part] {
+			t.Errorf("part %d answer mismatch (-want +got):\n%s", part, diff(want[part], got[part]))
+		}
+	}
+}
+
+func formatGolden(answers map[int]string) []byte {
+	var buf bytes.Buffer
+	for _, part := range []int{1, 2} {
+		fmt.Fprintf(&buf, "-- part %d --\n", part)
+		buf.WriteString(answers[part])
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
+
+func parseGolden(golden string) (map[int]string, error) {
+	answers := map[int]string{}
+	part := 0
+	var lines []string
+
+	flush := func() {
+		if part != 0 {
+			answers[part] = strings.Join(lines, "\n")
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimRight(golden, "\n"), "\n") {
+		matches := goldenSectionRegex.FindStringSubmatch(line)
+		if matches == nil {
+			if part == 0 {
+				return nil, fmt.Errorf("answer %q is outside of a part section", line)
+			}
+
+			lines = append(lines, line)
+			continue
+		}
+
+		flush()
+		part, _ = strconv.Atoi(matches[1])
+		lines = nil
+	}
+	flush()
+
+	return answers, nil
+}
+
+// diff returns a line by line diff of two answers, based on their longest
+// common subsequence of lines.
+func diff(want, got string) string {
+	a, b := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			fmt.Fprintf(&buf, "  %s\n", a[i])
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			fmt.Fprintf(&buf, "- %s\n", a[i])
+			i++
+		default:
+			fmt.Fprintf(&buf, "+ %s\n", b[j])
+			j++
+		}
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,80 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError reports puzzle input that doesn't have the shape a parser
+// expects.
+type ParseError struct {
+	Day      int    // day of the puzzle, or 0 if not known yet
+	Line     int    // line number, starting at 1
+	Column   int    // column number, starting at 1, or 0 if the whole line is at fault
+	Text     string // the offending line
+	Expected string // description of what the parser expected to find
+	Err      error  // underlying error, if any
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	if e.Day != 0 {
+		fmt.Fprintf(&b, "day %d: ", e.Day)
+	}
+
+	fmt.Fprintf(&b, "line %d", e.Line)
+	if e.Column > 0 {
+		fmt.Fprintf(&b, ", column %d", e.Column)
+	}
+
+	fmt.Fprintf(&b, ": %s", e.message())
+
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ParseError) message() string {
+	message := "expected " + e.Expected
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+
+	return message
+}
+
+// Diagnostic formats the error the way a compiler would, naming the input
+// file and pointing at the offending part of the line:
+//
+//	input.txt:3:3: day 2: expected a response of X, Y or Z
+//	    A Q
+//	      ^
+func (e *ParseError) Diagnostic(filename string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s:%d:", filename, e.Line)
+	if e.Column > 0 {
+		fmt.Fprintf(&b, "%d:", e.Column)
+	}
+	if e.Day != 0 {
+		fmt.Fprintf(&b, " day %d:", e.Day)
+	}
+	fmt.Fprintf(&b, " %s\n", e.message())
+
+	fmt.Fprintf(&b, "    %s\n", e.Text)
+
+	// Underline the whole line when no single column is at fault
+	trimmed := strings.TrimLeft(e.Text, " \t")
+	start, width := len(e.Text)-len(trimmed), len([]rune(trimmed))
+	if e.Column > 0 {
+		start, width = e.Column-1, 1
+	}
+	if width == 0 {
+		width = 1
+	}
+	fmt.Fprintf(&b, "    %s%s\n", strings.Repeat(" ", start), strings.Repeat("^", width))
+
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package input
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	cause := errors.New("invalid syntax")
+
+	tests := []struct {
+		name       string
+		err        *ParseError
+		error      string
+		diagnostic string
+	}{
+		{
+			name:       "column",
+			err:        &ParseError{Day: 2, Line: 3, Column: 3, Text: "A Q", Expected: "a response of X, Y or Z"},
+			error:      "day 2: line 3, column 3: expected a response of X, Y or Z",
+			diagnostic: "input.txt:3:3: day 2: expected a response of X, Y or Z\n    A Q\n      ^\n",
+		},
+		{
+			name:       "whole line",
+			err:        &ParseError{Day: 4, Line: 1, Text: "2-4;6-8", Expected: "a pair", Err: cause},
+			error:      "day 4: line 1: expected a pair: invalid syntax",
+			diagnostic: "input.txt:1: day 4: expected a pair: invalid syntax\n    2-4;6-8\n    ^^^^^^^\n",
+		},
+		{
+			name:       "leading whitespace",
+			err:        &ParseError{Day: 5, Line: 2, Text: "  \t[A]é", Expected: "a crate"},
+			error:      "day 5: line 2: expected a crate",
+			diagnostic: "input.txt:2: day 5: expected a crate\n      \t[A]é\n       ^^^^\n",
+		},
+		{
+			name:       "empty line",
+			err:        &ParseError{Day: 1, Line: 7, Expected: "a number"},
+			error:      "day 1: line 7: expected a number",
+			diagnostic: "input.txt:7: day 1: expected a number\n    \n    ^\n",
+		},
+		{
+			name:       "unknown day",
+			err:        &ParseError{Line: 2, Column: 1, Text: "x", Expected: "a digit"},
+			error:      "line 2, column 1: expected a digit",
+			diagnostic: "input.txt:2:1: expected a digit\n    x\n    ^\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.err.Error(); got != test.error {
+				t.Errorf("Error() = %q, want %q", got, test.error)
+			}
+			if got := test.err.Diagnostic("input.txt"); got != test.diagnostic {
+				t.Errorf("Diagnostic() = %q, want %q", got, test.diagnostic)
+			}
+		})
+	}
+
+	if !errors.Is(tests[1].err, cause) {
+		t.Error("the parse error doesn't wrap its cause")
+	}
+}
@@ -0,0 +1,182 @@
+// Package input reads puzzle inputs in the handful of shapes the puzzles use.
+//
+// Every reader normalizes the input first: CRLF line endings become LF and
+// trailing newlines are dropped, so a file saved with or without a final
+// newline parses the same way.
+package input
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Stdin is the path that Open treats as standard input.
+const Stdin = "-"
+
+// Open opens the puzzle input at path, or standard input if path is Stdin.
+func Open(path string) (io.ReadCloser, error) {
+	if path == Stdin {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+
+	return os.Open(path)
+}
+
+// Read returns the whole normalized input.
+func Read(r io.Reader) (string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	return normalize(string(b)), nil
+}
+
+func normalize(input string) string {
+	input = strings.ReplaceAll(input, "\r\n", "\n")
+	return strings.TrimRight(input, "\n")
+}
+
+// Lines returns every line of the input. An empty input has no lines.
+func Lines(r io.Reader) ([]string, error) {
+	input, err := Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if input == "" {
+		return nil, nil
+	}
+
+	return strings.Split(input, "\n"), nil
+}
+
+// Block is a group of consecutive non-blank lines.
+type Block struct {
+	Line  int // line number of the first line in the block
+	Lines []string
+}
+
+// Blocks returns the groups of lines separated by blank lines. Runs of blank
+// lines are treated as a single separator.
+func Blocks(r io.Reader) ([]*Block, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*Block
+	var block *Block
+	for idx, line := range lines {
+		if line == "" {
+			block = nil
+			continue
+		}
+
+		if block == nil {
+			block = &Block{Line: idx + 1}
+			blocks = append(blocks, block)
+		}
+
+		block.Lines = append(block.Lines, line)
+	}
+
+	return blocks, nil
+}
+
+// RuneGrid returns the input as a grid of characters, one row per line.
+// Every row must have the same width.
+func RuneGrid(r io.Reader) ([][]rune, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make([][]rune, len(lines))
+	for idx, line := range lines {
+		grid[idx] = []rune(line)
+
+		if err := checkRowWidth(len(grid[idx]), len(grid[0]), idx, line); err != nil {
+			return nil, err
+		}
+	}
+
+	return grid, nil
+}
+
+func checkRowWidth(width, firstRowWidth, rowIdx int, line string) error {
+	if width == firstRowWidth {
+		return nil
+	}
+
+	column := firstRowWidth + 1
+	if width < firstRowWidth {
+		column = width + 1
+	}
+
+	return &ParseError{
+		Line:     rowIdx + 1,
+		Column:   column,
+		Text:     line,
+		Expected: fmt.Sprintf("a row of width %d like the first row, got width %d", firstRowWidth, width),
+	}
+}
+
+// DigitGrid returns the input as a grid of single digit numbers, one row per
+// line. Every row must have the same width.
+func DigitGrid(r io.Reader) ([][]int, error) {
+	runes, err := RuneGrid(r)
+	if err != nil {
+		return nil, err
+	}
+
+	grid := make([][]int, len(runes))
+	for rowIdx, line := range runes {
+		row := make([]int, len(line))
+		for columnIdx, character := range line {
+			if character < '0' || character > '9' {
+				return nil, &ParseError{
+					Line:     rowIdx + 1,
+					Column:   columnIdx + 1,
+					Text:     string(line),
+					Expected: fmt.Sprintf("a digit, got %q", character),
+				}
+			}
+
+			row[columnIdx] = int(character - '0')
+		}
+
+		grid[rowIdx] = row
+	}
+
+	return grid, nil
+}
+
+// Records matches every line of the input against re and returns the
+// submatches of each line, without the full match. Every line must match.
+func Records(r io.Reader, re *regexp.Regexp) ([][]string, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([][]string, len(lines))
+	for idx, line := range lines {
+		matches := re.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, &ParseError{
+				Line:     idx + 1,
+				Text:     line,
+				Expected: fmt.Sprintf("a line matching %s", re),
+			}
+		}
+
+		records[idx] = matches[1:]
+	}
+
+	return records, nil
+}
@@ -0,0 +1,136 @@
+package input
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"empty", "", nil},
+		{"only newlines", "\n\n", nil},
+		{"no final newline", "a\nb", []string{"a", "b"}},
+		{"final newline", "a\nb\n", []string{"a", "b"}},
+		{"several final newlines", "a\nb\n\n\n", []string{"a", "b"}},
+		{"CRLF", "a\r\nb\r\n", []string{"a", "b"}},
+		{"blank line inside", "a\r\n\r\nb", []string{"a", "", "b"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lines, err := Lines(strings.NewReader(test.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(lines, test.want) {
+				t.Errorf("lines = %q, want %q", lines, test.want)
+			}
+		})
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []*Block
+	}{
+		{
+			name:  "final newlines make no extra block",
+			input: "1\n2\n\n3\n\n",
+			want:  []*Block{{Line: 1, Lines: []string{"1", "2"}}, {Line: 4, Lines: []string{"3"}}},
+		},
+		{
+			name:  "runs of blank lines are one separator",
+			input: "1\n\n\n\n2\r\n\r\n3",
+			want:  []*Block{{Line: 1, Lines: []string{"1"}}, {Line: 5, Lines: []string{"2"}}, {Line: 7, Lines: []string{"3"}}},
+		},
+		{
+			name:  "leading blank lines",
+			input: "\n\n1",
+			want:  []*Block{{Line: 3, Lines: []string{"1"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blocks, err := Blocks(strings.NewReader(test.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(blocks, test.want) {
+				t.Errorf("blocks = %+v, want %+v", blocks, test.want)
+			}
+		})
+	}
+}
+
+// parseError checks that err is a ParseError at line and column.
+func parseError(t *testing.T, err error, line, column int) {
+	t.Helper()
+
+	var parseError *ParseError
+	if !errors.As(err, &parseError) {
+		t.Fatalf("err = %v, want a parse error", err)
+	}
+	if parseError.Line != line || parseError.Column != column {
+		t.Errorf("error at %d:%d, want %d:%d", parseError.Line, parseError.Column, line, column)
+	}
+}
+
+func TestRuneGrid(t *testing.T) {
+	grid, err := RuneGrid(strings.NewReader("ab\r\ncd\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]rune{{'a', 'b'}, {'c', 'd'}}; !reflect.DeepEqual(grid, want) {
+		t.Errorf("grid = %q, want %q", grid, want)
+	}
+
+	_, err = RuneGrid(strings.NewReader("abc\nabc\nab"))
+	parseError(t, err, 3, 3)
+
+	_, err = RuneGrid(strings.NewReader("abc\nabcd"))
+	parseError(t, err, 2, 4)
+}
+
+func TestDigitGrid(t *testing.T) {
+	grid, err := DigitGrid(strings.NewReader("12\n34\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]int{{1, 2}, {3, 4}}; !reflect.DeepEqual(grid, want) {
+		t.Errorf("grid = %v, want %v", grid, want)
+	}
+
+	_, err = DigitGrid(strings.NewReader("123\n12"))
+	parseError(t, err, 2, 3)
+
+	_, err = DigitGrid(strings.NewReader("123\n1234"))
+	parseError(t, err, 2, 4)
+
+	_, err = DigitGrid(strings.NewReader("123\n1x3"))
+	parseError(t, err, 2, 2)
+}
+
+func TestRecords(t *testing.T) {
+	re := regexp.MustCompile(`^(\d+)-(\d+)$`)
+
+	records, err := Records(strings.NewReader("1-2\r\n3-4\n"), re)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][]string{{"1", "2"}, {"3", "4"}}; !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %q, want %q", records, want)
+	}
+
+	_, err = Records(strings.NewReader("1-2\n3+4\n5-6"), re)
+	parseError(t, err, 2, 0)
+}
@@ -0,0 +1,123 @@
+// Package interval implements set operations on closed integer ranges, such
+// as the sections assigned to the elves on day 4.
+package interval
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Interval is the closed range of integers from Start to End, including both.
+// Intervals are expected to have Start <= End.
+type Interval struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// New returns the interval from start to end, or an error if it is reversed.
+func New(start, end int) (Interval, error) {
+	if start > end {
+		return Interval{}, fmt.Errorf("reversed interval %d-%d", start, end)
+	}
+
+	return Interval{Start: start, End: end}, nil
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("%d-%d", i.Start, i.End)
+}
+
+// Length returns the number of integers in the interval.
+func (i Interval) Length() int {
+	return i.End - i.Start + 1
+}
+
+// Contains reports whether every integer in other is also in i.
+func (i Interval) Contains(other Interval) bool {
+	return i.Start <= other.Start && other.End <= i.End
+}
+
+// Overlaps reports whether i and other have any integer in common.
+func (i Interval) Overlaps(other Interval) bool {
+	return i.Start <= other.End && other.Start <= i.End
+}
+
+// Intersect returns the integers in both i and other, and false if there are
+// none.
+func (i Interval) Intersect(other Interval) (Interval, bool) {
+	if !i.Overlaps(other) {
+		return Interval{}, false
+	}
+
+	return Interval{Start: max(i.Start, other.Start), End: min(i.End, other.End)}, true
+}
+
+// Union returns the integers in either i or other, as a single interval if
+// they overlap or are adjacent and as two ordered intervals otherwise.
+func (i Interval) Union(other Interval) []Interval {
+	return Merge([]Interval{i, other})
+}
+
+// Subtract returns the integers in i that aren't in other, as up to two
+// ordered intervals.
+func (i Interval) Subtract(other Interval) []Interval {
+	if !i.Overlaps(other) {
+		return []Interval{i}
+	}
+
+	var remaining []Interval
+	if i.Start < other.Start {
+		remaining = append(remaining, Interval{Start: i.Start, End: other.Start - 1})
+	}
+	if other.End < i.End {
+		remaining = append(remaining, Interval{Start: other.End + 1, End: i.End})
+	}
+
+	return remaining
+}
+
+// Merge returns the integers in any of the intervals as the fewest ordered
+// intervals, joining any that overlap or are adjacent.
+func Merge(intervals []Interval) []Interval {
+	sorted := append([]Interval(nil), intervals...)
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Start < sorted[b].Start
+	})
+
+	var merged []Interval
+	for _, interval := range sorted {
+		last := len(merged) - 1
+		if last >= 0 && interval.Start <= merged[last].End+1 {
+			merged[last].End = max(merged[last].End, interval.End)
+			continue
+		}
+
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
+
+// Length returns the number of integers in any of the intervals.
+func Length(intervals []Interval) int {
+	total := 0
+	for _, interval := range Merge(intervals) {
+		total += interval.Length()
+	}
+
+	return total
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,199 @@
+package interval
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+// config generates short intervals close together, so that most pairs
+// overlap, touch or nest.
+var config = &quick.Config{
+	MaxCount: 5000,
+	Values: func(values []reflect.Value, rng *rand.Rand) {
+		for idx := range values {
+			start := rng.Intn(20)
+			values[idx] = reflect.ValueOf(Interval{Start: start, End: start + rng.Intn(10)})
+		}
+	},
+}
+
+// integers returns the set of integers in the intervals, for checking the
+// operations against.
+func integers(intervals ...Interval) map[int]bool {
+	set := map[int]bool{}
+	for _, interval := range intervals {
+		for n := interval.Start; n <= interval.End; n++ {
+			set[n] = true
+		}
+	}
+
+	return set
+}
+
+func check(t *testing.T, property interface{}) {
+	t.Helper()
+
+	if err := quick.Check(property, config); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRelations(t *testing.T) {
+	t.Run("overlaps is symmetric", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return a.Overlaps(b) == b.Overlaps(a)
+		})
+	})
+
+	t.Run("mutual containment is equality", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return (a.Contains(b) && b.Contains(a)) == (a == b)
+		})
+	})
+
+	t.Run("containment implies overlap", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return !a.Contains(b) || a.Overlaps(b)
+		})
+	})
+
+	t.Run("relations agree with the integers", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			inA, inB := integers(a), integers(b)
+
+			contains, overlaps := true, false
+			for n := range inB {
+				contains = contains && inA[n]
+				overlaps = overlaps || inA[n]
+			}
+
+			return a.Contains(b) == contains && a.Overlaps(b) == overlaps
+		})
+	})
+}
+
+func TestIntersect(t *testing.T) {
+	t.Run("is symmetric", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			ab, okAB := a.Intersect(b)
+			ba, okBA := b.Intersect(a)
+			return ab == ba && okAB == okBA
+		})
+	})
+
+	t.Run("exists when the intervals overlap", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			_, ok := a.Intersect(b)
+			return ok == a.Overlaps(b)
+		})
+	})
+
+	t.Run("is contained by both", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			intersection, ok := a.Intersect(b)
+			return !ok || a.Contains(intersection) && b.Contains(intersection)
+		})
+	})
+
+	t.Run("is the smaller interval when one contains the other", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			intersection, _ := a.Intersect(b)
+			return !a.Contains(b) || intersection == b
+		})
+	})
+}
+
+func TestUnion(t *testing.T) {
+	t.Run("is symmetric", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return reflect.DeepEqual(a.Union(b), b.Union(a))
+		})
+	})
+
+	t.Run("covers exactly the integers of both", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return reflect.DeepEqual(integers(a.Union(b)...), integers(a, b))
+		})
+	})
+
+	t.Run("is one interval when they overlap", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return !a.Overlaps(b) || len(a.Union(b)) == 1
+		})
+	})
+
+	t.Run("counts the intersection once", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			intersection, ok := a.Intersect(b)
+			shared := 0
+			if ok {
+				shared = intersection.Length()
+			}
+
+			return Length(a.Union(b)) == a.Length()+b.Length()-shared
+		})
+	})
+}
+
+func TestSubtract(t *testing.T) {
+	t.Run("covers the integers only in the first", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			want := integers(a)
+			for n := range integers(b) {
+				delete(want, n)
+			}
+
+			return reflect.DeepEqual(integers(a.Subtract(b)...), want)
+		})
+	})
+
+	t.Run("leaves nothing when subtracting a container", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			return !b.Contains(a) || len(a.Subtract(b)) == 0
+		})
+	})
+
+	t.Run("adds back up with the intersection", func(t *testing.T) {
+		check(t, func(a, b Interval) bool {
+			remaining := a.Subtract(b)
+			if intersection, ok := a.Intersect(b); ok {
+				remaining = append(remaining, intersection)
+			}
+
+			return Length(remaining) == a.Length()
+		})
+	})
+}
+
+func TestMerge(t *testing.T) {
+	check(t, func(a, b, c, d Interval) bool {
+		intervals := []Interval{a, b, c, d}
+		merged := Merge(intervals)
+
+		for idx := 1; idx < len(merged); idx++ {
+			// Merged intervals must be ordered with a gap between them
+			if merged[idx].Start <= merged[idx-1].End+1 {
+				return false
+			}
+		}
+
+		return reflect.DeepEqual(integers(merged...), integers(intervals...)) &&
+			Length(intervals) == len(integers(intervals...))
+	})
+
+	if merged := Merge(nil); len(merged) != 0 {
+		t.Errorf("Merge(nil) = %v, want nothing", merged)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if interval, err := New(3, 3); err != nil || interval.Length() != 1 {
+		t.Errorf("New(3, 3) = %v, %v, want a single section", interval, err)
+	}
+
+	if _, err := New(4, 2); err == nil {
+		t.Error("expected an error for a reversed interval")
+	}
+}
@@ -0,0 +1,51 @@
+// Package jsonlist keeps records in files holding a JSON array, such as the
+// benchmark history and the answer ledger.
+package jsonlist
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Load reads every record in the file at path, oldest first. A missing file
+// holds no records.
+func Load[T any](path string) ([]T, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []T
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Append adds a record to the end of the file at path, creating the file and
+// its directory if needed.
+func Append[T any](path string, record T) error {
+	records, err := Load[T](path)
+	if err != nil {
+		return err
+	}
+
+	records = append(records, record)
+
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
@@ -0,0 +1,30 @@
+package jsonlist
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestAppend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "records.json")
+
+	records, err := Load[int](path)
+	if err != nil || records != nil {
+		t.Fatalf("Load of a missing file = %v, %v, want no records", records, err)
+	}
+
+	for _, record := range []int{3, 1, 2} {
+		if err := Append(path, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err = Load[int](path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, 1, 2}; !reflect.DeepEqual(records, want) {
+		t.Errorf("records = %v, want %v", records, want)
+	}
+}
@@ -0,0 +1,66 @@
+package puzzle
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Answer is the solution to one part of a puzzle. Most answers are numbers,
+// but some puzzles are answered with text such as the crates on top of each
+// stack. Answers can be compared with ==.
+type Answer struct {
+	text     string
+	number   int
+	isNumber bool
+}
+
+// Int returns a numeric answer.
+func Int(n int) Answer {
+	return Answer{number: n, isNumber: true}
+}
+
+// Text returns a textual answer.
+func Text(s string) Answer {
+	return Answer{text: s}
+}
+
+// Int returns the value of a numeric answer. The second result is false if the
+// answer isn't a number.
+func (a Answer) Int() (int, bool) {
+	return a.number, a.isNumber
+}
+
+func (a Answer) String() string {
+	if a.isNumber {
+		return strconv.Itoa(a.number)
+	}
+
+	return a.text
+}
+
+// MarshalJSON encodes numeric answers as JSON numbers and textual answers as
+// JSON strings.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	if a.isNumber {
+		return json.Marshal(a.number)
+	}
+
+	return json.Marshal(a.text)
+}
+
+// UnmarshalJSON decodes an answer written by MarshalJSON.
+func (a *Answer) UnmarshalJSON(b []byte) error {
+	var number int
+	if err := json.Unmarshal(b, &number); err == nil {
+		*a = Int(number)
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(b, &text); err != nil {
+		return err
+	}
+
+	*a = Text(text)
+	return nil
+}
@@ -0,0 +1,65 @@
+// Package puzzle keeps track of the solvers for each day of the advent calendar.
+package puzzle
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Solver solves both parts of a day's puzzle. Parse is called once with the
+// puzzle input before either part is solved, and solving one part must not
+// change the parsed input seen by the other.
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1() (Answer, error)
+	Part2() (Answer, error)
+}
+
+// NewSolverFunc returns a fresh solver that hasn't parsed any input yet.
+type NewSolverFunc func() Solver
+
+var registry = map[int]NewSolverFunc{}
+
+// Register makes the solver for a day available to the runner. It is meant to
+// be called from the init function of each day's package.
+func Register(day int, newSolver NewSolverFunc) {
+	if _, ok := registry[day]; ok {
+		panic(fmt.Sprintf("puzzle: day %d registered twice", day))
+	}
+
+	registry[day] = newSolver
+}
+
+// Lookup returns a new solver for a day.
+func Lookup(day int) (Solver, bool) {
+	newSolver, ok := registry[day]
+	if !ok {
+		return nil, false
+	}
+
+	return newSolver(), true
+}
+
+// Days returns every registered day in ascending order.
+func Days() []int {
+	var days []int
+	for day := range registry {
+		days = append(days, day)
+	}
+	sort.Ints(days)
+
+	return days
+}
+
+// Solve solves a single part of a puzzle whose input has already been parsed.
+func Solve(solver Solver, part int) (Answer, error) {
+	switch part {
+	case 1:
+		return solver.Part1()
+	case 2:
+		return solver.Part2()
+	default:
+		return Answer{}, fmt.Errorf("unknown part: %d", part)
+	}
+}
@@ -0,0 +1,131 @@
+// Package scaffold generates the package for a new day from templates that
+// follow the layout of the existing days.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const module = "github.com/darthchudi/aoc2022"
+
+//go:embed templates/*.tmpl
+var templateFiles embed.FS
+
+var templates = template.Must(template.ParseFS(templateFiles, "templates/*.tmpl"))
+
+// files maps each template to the file it generates, relative to the day's
+// package directory.
+var files = map[string]string{
+	"day.go.tmpl":      "day%d.go",
+	"day_test.go.tmpl": "day%d_test.go",
+}
+
+// Generate creates the package for a day under the repository root, registers
+// it in days/days.go and creates days/testdata/dayN for its example inputs.
+// It returns the paths of the files it wrote or changed followed by the
+// testdata directory, and refuses to touch a day that already exists.
+func Generate(root string, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day: %d", day)
+	}
+
+	dir := filepath.Join(root, fmt.Sprintf("day%d", day))
+	if _, err := os.Stat(dir); err == nil {
+		return nil, fmt.Errorf("%s already exists", dir)
+	}
+
+	registryPath := filepath.Join(root, "days", "days.go")
+	registry, err := ioutil.ReadFile(registryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err = register(registry, day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register day %d in %s: %v", day, registryPath, err)
+	}
+
+	// Render everything before writing anything so a broken template can't
+	// leave a half generated day behind
+	generated := map[string][]byte{}
+	for name, file := range files {
+		src, err := render(name, day)
+		if err != nil {
+			return nil, err
+		}
+
+		generated[filepath.Join(dir, fmt.Sprintf(file, day))] = src
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for path, src := range generated {
+		if err := ioutil.WriteFile(path, src, 0644); err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	if err := ioutil.WriteFile(registryPath, registry, 0644); err != nil {
+		return nil, err
+	}
+
+	// The golden tests skip a day until its examples are added here
+	testdata := filepath.Join(root, "days", "testdata", fmt.Sprintf("day%d", day))
+	if err := os.MkdirAll(testdata, 0755); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	return append(paths, registryPath, testdata), nil
+}
+
+func render(name string, day int) ([]byte, error) {
+	var b bytes.Buffer
+	if err := templates.ExecuteTemplate(&b, name, struct{ Day int }{day}); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("template %s generated invalid code: %v", name, err)
+	}
+
+	return src, nil
+}
+
+// register adds a blank import of the day's package to the source of
+// days/days.go, keeping the imports sorted.
+func register(registry []byte, day int) ([]byte, error) {
+	importLine := fmt.Sprintf("\t_ %q\n", fmt.Sprintf("%s/day%d", module, day))
+
+	src := string(registry)
+	if strings.Contains(src, importLine) {
+		return nil, fmt.Errorf("day %d is already registered", day)
+	}
+
+	start := strings.Index(src, "import (\n")
+	if start == -1 {
+		return nil, fmt.Errorf("no import block")
+	}
+
+	end := start + len("import (\n")
+	src = src[:end] + importLine + src[end:]
+
+	// gofmt sorts the imports within the block
+	return format.Source([]byte(src))
+}
@@ -0,0 +1,76 @@
+package scaffold
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const registry = `package days
+
+import (
+	_ "github.com/darthchudi/aoc2022/day1"
+	_ "github.com/darthchudi/aoc2022/day11"
+	_ "github.com/darthchudi/aoc2022/day2"
+)
+`
+
+func TestGenerate(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "days"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(root, "days", "days.go"), []byte(registry), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := Generate(root, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		filepath.Join(root, "day12", "day12.go"),
+		filepath.Join(root, "day12", "day12_test.go"),
+		filepath.Join(root, "days", "days.go"),
+		filepath.Join(root, "days", "testdata", "day12"),
+	}
+	if strings.Join(paths, "\n") != strings.Join(want, "\n") {
+		t.Errorf("wrote %v, want %v", paths, want)
+	}
+
+	src, err := ioutil.ReadFile(filepath.Join(root, "day12", "day12.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "puzzle.Register(12, ") {
+		t.Errorf("day12.go doesn't register day 12:\n%s", src)
+	}
+
+	registered, err := ioutil.ReadFile(filepath.Join(root, "days", "days.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	day11 := strings.Index(string(registered), `aoc2022/day11"`)
+	day12 := strings.Index(string(registered), `aoc2022/day12"`)
+	day2 := strings.Index(string(registered), `aoc2022/day2"`)
+	if day12 == -1 || day12 < day11 || day12 > day2 {
+		t.Errorf("day12 isn't imported in sorted order:\n%s", registered)
+	}
+
+	// The golden tests skip the day rather than fail until examples are added
+	examples, err := filepath.Glob(filepath.Join(root, "days", "testdata", "day12", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info, err := os.Stat(filepath.Join(root, "days", "testdata", "day12")); err != nil || !info.IsDir() || len(examples) != 0 {
+		t.Errorf("expected an empty testdata directory for day 12, got %v and examples %v", err, examples)
+	}
+
+	if _, err := Generate(root, 12); err == nil {
+		t.Error("expected an error when generating an existing day")
+	}
+}